@@ -0,0 +1,70 @@
+package core_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// document is a long input for the grammar, with an error to recover from
+// every so often.
+func document(statements int) []byte {
+	lines := []string{"let x = 1.5;", "print x (y) 2;", "let y = (x;", "print;"}
+	var document strings.Builder
+	for i := 0; i < statements; i++ {
+		document.WriteString(lines[i%len(lines)] + "\n")
+	}
+	return []byte(document.String())
+}
+
+// BenchmarkMemo compares the layouts of memoization tables, for recursive and
+// stack-safe parsers.
+func BenchmarkMemo(b *testing.B) {
+	input := document(2000)
+	for _, variant := range []string{"maps", "dense", "every", "stack", "stackdense"} {
+		parser := parsers[variant]
+		b.Run(variant, func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			for i := 0; i < b.N; i++ {
+				parser.ParseDoc(input)
+			}
+		})
+	}
+}
+
+// BenchmarkLength parses longer and longer inputs, with the memoization tables
+// unbounded or held to a MemoBudget. The parse takes as many steps per byte at
+// every length (TestLinearSteps checks that), and no regex searches past where
+// it's tried (TestAnchoredRegex), but the time per byte of an unbounded Doc
+// still grows, roughly doubling from 16KiB to 256KiB: its tables hold hundreds
+// of bytes per byte of input, and soon outgrow the processor's caches. With a
+// budget, Doc's time per byte stays flat. What remains of the growth, in both
+// roots, is the garbage collector scanning the values built so far, which
+// starts once the heap passes a few megabytes; with GOGC=off it goes away.
+func BenchmarkLength(b *testing.B) {
+	for _, size := range []int{1 << 10, 1 << 14, 1 << 18} {
+		inputs := map[string][]byte{
+			"Letters": []byte(strings.Repeat("ab", size/2)),
+			"Doc":     document(size / 16),
+		}
+		for _, root := range []string{"Letters", "Doc"} {
+			for _, budget := range []int{0, 1 << 12} {
+				input, limits := inputs[root], runtime.Limits{MemoBudget: budget}
+				b.Run(fmt.Sprintf("%s/budget=%d/%d", root, budget, size), func(b *testing.B) {
+					parser := parsers["maps"]
+					for i := 0; i < b.N; i++ {
+						if root == "Letters" {
+							parser.ParseLettersContext(context.Background(), input, limits)
+						} else {
+							parser.ParseDocContext(context.Background(), input, limits)
+						}
+					}
+					b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(input)), "ns/byte")
+				})
+			}
+		}
+	}
+}
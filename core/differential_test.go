@@ -0,0 +1,158 @@
+package core_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testgrammar"
+)
+
+// Every variant of the generated parser, recursive or stack-safe and with
+// either layout of memoization tables, agrees with the interpreter. Where a row
+// pins the outcome, as its summary, they all have it.
+func TestDifferential(t *testing.T) {
+	interpreter, err := testgrammar.Interpreter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		root  string
+		input string
+		want  string
+	}{
+		{"Doc", "", ""},
+		{"Doc", "let x = 1;", ""},
+		{"Doc", "let x = 1.25e3; print x (y) (((2)));\n\tprint 3;", ""},
+		// Every labeled failure knows where its sequence began, but only says
+		// so if the Label asks.
+		{"Doc", "let x 1;", "[?] 1 error: 1:7: missing equals sign (opened at 1:1)"},
+		{"Doc", "print(1;", "[?] 1 error: 1:8: unclosed parenthesis opened at 1:6 (opened at 1:6)"},
+		{"Doc", "let = 1;", ""},
+		{"Doc", "let let = 1;", ""},
+		{"Doc", "letx = 1;", ""},
+		{"Doc", "print;", ""},
+		{"Doc", "print (1;", ""},
+		{"Doc", "print ((x) y;", ""},
+		// An Alias around a Try reports the Try's error, rather than its name.
+		{"Doc", "let x = 1e999;", `[?] 1 error: 1:9: strconv.ParseFloat: parsing "1e999": value out of range`},
+		{"Doc", "let x = 1.;", ""},
+		{"Doc", "print !;", ""},
+		{"Doc", "print x !;", ""},
+		{"Doc", "print x\n;;", ""},
+		{"Doc", "let x = 1; ~; print é;", ""},
+		{"Doc", "let x = 1", ""},
+		{"Doc", "print 1 2 3; garbage", ""},
+		{"Doc", "print\r\n(x)\r\n;", ""},
+		// A Try's error is reported even where its argument could have gone
+		// further, with no cut to make the failure fatal.
+		{"Number", "1e999", `1 error: 1:1: strconv.ParseFloat: parsing "1e999": value out of range`},
+		{"Number", "12", "12"},
+		// A Recover repeated by a Star stops when it has nothing left to skip,
+		// rather than matching nothing forever.
+		{"Items", "", "[]"},
+		{"Items", "a;", "[a]"},
+		{"Items", "a;b;", "[a b]"},
+		{"Items", "a ; b ;", "[a b]"},
+		{"Items", "a;1;b;", ""},
+		{"Items", "a;1 2;b;", `[a ?] 2 errors: 1:3: expected name, found "1 2;b;"`},
+		{"Items", "a;b", `[a ?] 1 error: 1:4: expected ";", found end of input`},
+		{"Items", "1", ""},
+		// A Star which stops at an iteration that recovered without consuming
+		// anything still reports what that iteration expected.
+		{"Items", "a;;", `1 error: 1:3: expected end of input or name, found ";"`},
+		// An Alias which skips whitespace before failing is named where the
+		// whitespace ends, rather than listing what its parts expected there.
+		{"Items", "a;\n é;", `[a ?] 2 errors: 2:2: expected name, found "é;"`},
+		// A cut inside a lookahead only decides whether the lookahead matches.
+		{"Word", "iffy", "iffy"},
+		{"Word", "if", "if"},
+		{"Word", "x", "x"},
+		{"Word", "if(", `1 error: 1:1: expected "x" or something other than "if" then "(", found "if("`},
+		{"Word", "y", "1 error: 1:2: expected text matching `[a-z]`, found end of input"},
+		{"Word", "abc1", ""},
+		{"Word", "", ""},
+		{"Letters", "", ""},
+		{"Letters", "ab", ""},
+		{"Letters", "aab", ""},
+		{"Letters", "abc", ""},
+		{"Letters", strings.Repeat("ba", 100), ""},
+		// What was parsed inside a lookahead is parsed again outside of it,
+		// so its failures are reported.
+		{"Nest", "((x])", "x"},
+		{"Nest", "((x])!", `1 error: 1:1: expected something other than nest then "!", found "((x])!"`},
+		{"Nest", "((x]", `1 error: 1:5: expected ")" or "]", found end of input`},
+	}
+	for _, test := range tests {
+		input := []byte(test.input)
+		value, err := interpreter.Parse(test.root, input)
+		want := show(value, err)
+		if got := summary(value, err); test.want != "" && got != test.want {
+			t.Errorf("interpreter: %s: %q gave %s, not %s", test.root, test.input, got, test.want)
+		}
+		_, length, err := interpreter.ParsePrefix(test.root, input)
+		wantPrefix := fmt.Sprint(length, " ", err)
+		for variant, parser := range parsers {
+			var value interface{}
+			var err error
+			switch test.root {
+			case "Doc":
+				value, err = parser.ParseDoc(input)
+				_, length, failed := parser.ParseDocPrefix(input)
+				if prefix := fmt.Sprint(length, " ", failed); prefix != wantPrefix {
+					t.Errorf("%s: %s prefix: %q gave\n%s\nnot\n%s", variant, test.root, test.input, prefix, wantPrefix)
+				}
+				if reader, got := show(parser.ParseDocReader(bytes.NewReader(input))), show(value, err); reader != got {
+					t.Errorf("%s: %s from a reader: %q gave\n%s\nnot\n%s", variant, test.root, test.input, reader, got)
+				}
+			case "Number":
+				value, err = parser.ParseNumber(input)
+			case "Items":
+				value, err = parser.ParseItems(input)
+			case "Word":
+				word, failed := parser.ParseWord(input)
+				value, err = word, failed
+				if word == "" {
+					// The interpreter's value is nil where the parser's is empty.
+					value = nil
+				}
+			case "Letters":
+				value, err = parser.ParseLetters(input)
+			case "Nest":
+				value, err = parser.ParseNest(input)
+			}
+			if got := show(value, err); got != want {
+				t.Errorf("%s: %s: %q gave\n%s\nnot\n%s", variant, test.root, test.input, got, want)
+			}
+			if got := summary(value, err); test.want != "" && got != test.want {
+				t.Errorf("%s: %s: %q gave %s, not %s", variant, test.root, test.input, got, test.want)
+			}
+		}
+	}
+}
+
+// The interpreter rejects a grammar with nodes it can't parse, or which uses a
+// root that isn't defined, when it's made rather than during a parse.
+func TestInterpreterRejects(t *testing.T) {
+	custom := core.NewState()
+	custom.DefineRoot("Doc", opaque{core.Literal("x")})
+	missing := core.NewState()
+	missing.DefineRoot("Doc", core.Sequence{core.Literal("x"), core.Root{Name: "rest", Type: "string"}})
+	unset := core.NewState()
+	unset.DefineRoot("Doc", core.Literal("x"))
+	unset.DefineWithName("\nreturn peg.Success(here), \"\"", "m_raw", "string", "raw", nil)
+	for _, test := range []struct {
+		state core.State
+		error string
+	}{
+		{custom, "can't parse core_test.opaque nodes"},
+		{missing, "m_rest, which is not defined"},
+		{unset, "can't parse <nil> nodes, as m_raw is"},
+	} {
+		if _, err := core.NewInterpreter(&test.state); err == nil || !strings.Contains(err.Error(), test.error) {
+			t.Errorf("got %v, not an error saying %q", err, test.error)
+		}
+	}
+}
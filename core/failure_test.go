@@ -0,0 +1,88 @@
+package core_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testgrammar"
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// A failed parse is reported at the farthest position any part of it reached,
+// with everything expected there, by the interpreter and every generated
+// parser. Each input begins with the digit selecting its grammar in Farthest.
+func TestFarthestFailure(t *testing.T) {
+	interpreter, err := testgrammar.Interpreter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		input    string
+		at       int
+		expected string
+	}{
+		{name: "sequence", input: "1abx", at: 3, expected: `"c"`},
+		{name: "alternate at the same position", input: "2x", at: 1, expected: `"a" "b" text matching ` + "`[0-9]`"},
+		{name: "alternate reaching further in one option", input: "3acx", at: 3, expected: `"d"`},
+		{name: "alternates in a sequence", input: "4bx", at: 2, expected: `"c" "e"`},
+		{name: "options failing at the same further position", input: "5ax", at: 2, expected: `"b" "c" "d"`},
+		{name: "a star stopping before the failure", input: "6aax", at: 3, expected: `"a" "b"`},
+		{name: "a failure inside a successful option", input: "7abz", at: 3, expected: `"c"`},
+		{name: "a failure inside a lookahead", input: "8ax", at: 2, expected: `"c"`},
+		{name: "the end of the input", input: "9abc", at: 2, expected: `end of input`},
+	}
+	for _, test := range tests {
+		_, err := interpreter.Parse("Farthest", []byte(test.input))
+		checkFailure(t, "interpreter: "+test.name, err, test.at, test.expected)
+		for variant, parser := range parsers {
+			_, err := parser.ParseFarthest([]byte(test.input))
+			checkFailure(t, variant+": "+test.name, err, test.at, test.expected)
+		}
+	}
+}
+
+// checkFailure reports whether err is a failure at the given offset, expecting what's
+// described.
+func checkFailure(t *testing.T, name string, err error, at int, expected string) {
+	failed, ok := err.(*runtime.ParseError)
+	if !ok {
+		t.Errorf("%s: got %v", name, err)
+		return
+	}
+	reasons := []string{}
+	for _, reject := range failed.Expected {
+		reasons = append(reasons, reject.Reason())
+	}
+	sort.Strings(reasons)
+	if failed.Offset != at || strings.Join(reasons, " ") != expected {
+		t.Errorf("%s: failed at %d expecting %s, not at %d expecting %s", name, failed.Offset, strings.Join(reasons, " "), at, expected)
+	}
+}
+
+// A failure where a Not found what it excludes describes that as the failures
+// of its argument would, rather than in the syntax of the grammar.
+func TestExcluded(t *testing.T) {
+	for _, test := range []struct {
+		excluded core.Peg
+		input    string
+		expected string
+	}{
+		{core.Regex{Regex: `[a-z0-9]`}, "x", "something other than text matching `[a-z0-9]`"},
+		{core.Sequence{core.Literal("if"), core.Cut{}, core.Literal("(")}, "if(", `something other than "if" then "("`},
+		{core.Alternate{core.Alias{Argument: core.Regex{Regex: `[0-9]+`}, Name: "number"}, core.Literal("x")}, "12", `something other than number or "x"`},
+		{core.Contents{Argument: core.Plus{Argument: core.Literal("a")}}, "aa", `something other than "a"`},
+		{core.Cut{}, "", "something else"},
+	} {
+		state := core.NewState()
+		state.DefineRoot("Test", core.Sequence{core.Not{Argument: test.excluded}, core.Regex{Regex: `.*`}})
+		interpreter, err := core.NewInterpreter(&state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = interpreter.Parse("Test", []byte(test.input))
+		checkFailure(t, test.expected, err, 0, test.expected)
+	}
+}
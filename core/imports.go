@@ -0,0 +1,102 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// pruneImports removes the imports of the source which nothing uses. Prune can
+// only tell which definitions needed the imports they list themselves, not
+// those added with State.AddImport for the grammar's Go expressions, so this
+// finds the ones that the definitions which remain don't use, and returns their
+// paths. An import without a name of its own is only removed if it's of the
+// standard library, whose packages are named after their paths; elsewhere, a
+// package can be named anything, so its import is kept in case the name is
+// used. Blank and dot imports are kept too.
+func pruneImports(source string) (string, []string, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "parse.go", source, parser.ParseComments)
+	if err != nil {
+		return "", nil, fmt.Errorf("pruning imports: %w", err)
+	}
+	info := &types.Info{Uses: map[*ast.Ident]types.Object{}}
+	config := types.Config{Importer: emptyImporter{}, Error: func(error) {}}
+	config.Check(file.Name.Name, fileSet, []*ast.File{file}, info)
+	used := map[string]bool{}
+	for _, object := range info.Uses {
+		if name, ok := object.(*types.PkgName); ok {
+			used[name.Imported().Path()] = true
+		}
+	}
+	unused := func(spec ast.Spec) bool {
+		imported := spec.(*ast.ImportSpec)
+		path, _ := strconv.Unquote(imported.Path.Value)
+		if imported.Name != nil && (imported.Name.Name == "_" || imported.Name.Name == ".") {
+			return false
+		}
+		if imported.Name == nil && !standard(path) {
+			return false
+		}
+		return !used[path]
+	}
+	removed := []string{}
+	decls := []ast.Decl{}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			specs := []ast.Spec{}
+			for _, spec := range gen.Specs {
+				if !unused(spec) {
+					specs = append(specs, spec)
+				} else {
+					path, _ := strconv.Unquote(spec.(*ast.ImportSpec).Path.Value)
+					removed = append(removed, path)
+				}
+			}
+			if len(specs) == 0 {
+				continue
+			}
+			gen.Specs = specs
+		}
+		decls = append(decls, decl)
+	}
+	file.Decls = decls
+	var output bytes.Buffer
+	if err := format.Node(&output, fileSet, file); err != nil {
+		return "", nil, fmt.Errorf("pruning imports: %w", err)
+	}
+	return output.String(), removed, nil
+}
+
+var majorVersion = regexp.MustCompile(`^v[0-9]+$`)
+
+// packageName is the name of the package with the given import path, going by
+// convention: the last element of the path, skipping a major version suffix
+// such as v2.
+func packageName(path string) string {
+	elements := strings.Split(path, "/")
+	name := elements[len(elements)-1]
+	if len(elements) > 1 && majorVersion.MatchString(name) {
+		name = elements[len(elements)-2]
+	}
+	return name
+}
+
+// standard reports whether the import path is of a package in the standard
+// library. Only those paths have no dot in their first element, and they're
+// looked up in GOROOT to be sure.
+func standard(path string) bool {
+	if strings.Contains(strings.Split(path, "/")[0], ".") {
+		return false
+	}
+	pkg, err := build.Import(path, "", build.FindOnly)
+	return err == nil && pkg.Goroot
+}
@@ -0,0 +1,74 @@
+package testgrammar_test
+
+import (
+	"flag"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testgrammar"
+)
+
+var update = flag.Bool("update", false, "regenerate the parsers under core/internal/testparse")
+
+// The parsers that the tests use are generated ahead of time, since a test
+// can't compile code. This checks that they are up to date.
+func TestGenerated(t *testing.T) {
+	for variant, options := range testgrammar.Variants {
+		state := testgrammar.Grammar()
+		source, err := state.GenerateWith(variant, options)
+		if err != nil {
+			t.Fatalf("%s: %v", variant, err)
+		}
+		checkGenerated(t, filepath.Join("..", "testparse", variant, "parse.go"), source)
+	}
+	// Each twin package holds two parsers for the grammar, which must compile
+	// side by side.
+	twins := map[string]core.Options{"twin": {}, "twinself": {SelfContained: true}}
+	for variant, options := range twins {
+		for _, prefix := range []string{"First", "Second"} {
+			options.Prefix = prefix
+			state := testgrammar.Grammar()
+			source, err := state.GenerateWith(variant, options)
+			if err != nil {
+				t.Fatalf("%s: %v", variant, err)
+			}
+			checkGenerated(t, filepath.Join("..", "testparse", variant, strings.ToLower(prefix)+".go"), source)
+		}
+	}
+}
+
+func checkGenerated(t *testing.T, path string, source string) {
+	t.Helper()
+	// Formatting the generated code once doesn't always finish the job.
+	formatted := []byte(source)
+	for {
+		again, err := format.Source(formatted)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if string(again) == string(formatted) {
+			break
+		}
+		formatted = again
+	}
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, formatted, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	committed, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(committed) != string(formatted) {
+		t.Errorf("%s is out of date; run go test -run TestGenerated -update", path)
+	}
+}
@@ -0,0 +1,198 @@
+// Package testgrammar defines a grammar which uses every kind of node, for the
+// tests of core. The parsers under core/internal/testparse are generated from
+// it, with each of the Variants, and are checked against the Interpreter.
+package testgrammar
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// Variants are the options each generated parser was made with, by the name of
+// its package under core/internal/testparse.
+var Variants = map[string]core.Options{
+	"maps":       {},
+	"dense":      {Memo: core.MemoDense},
+	"every":      {Policy: core.MemoEvery},
+	"stack":      {StackSafe: true},
+	"stackdense": {StackSafe: true, Memo: core.MemoDense},
+}
+
+// Grammar is a language of statements separated by semicolons, such as
+//
+//	let x = 1.5; print x (2);
+//
+// Doc parses a document, recovering from statements which fail. Number parses
+// a number alone, with no cut before it. Items parses names ending in
+// semicolons, recovering from each. Word parses a word of two letters or more,
+// or "x", through lookaheads with cuts. Letters parses a run of "a"s and "b"s,
+// for long inputs. Nest parses "x" in parentheses closed by ")" or "]", unless
+// followed by "!", which backtracks over every level inside the lookahead.
+// Farthest parses a digit and then the small grammar it selects, each of which
+// fails in its own way.
+func Grammar() core.State {
+	state := core.NewState()
+	state.AddImports([]string{"errors", "strconv", "strings"})
+	space := core.Root{Name: "space", Type: "string"}
+	end := core.Not{Argument: core.Regex{Regex: `[a-z0-9]`}}
+
+	state.DefineRoot("space", core.Regex{Regex: `[ \t\r\n]*`})
+	state.DefineRoot("keyword", core.Memo{Argument: core.Go{
+		Argument:   core.Sequence{core.Alternate{core.Literal("let"), core.Literal("print")}, end},
+		Returns:    "string",
+		Expression: "arg.V0",
+	}})
+	state.DefineRoot("name", core.Alias{Argument: core.Go{
+		Argument:   core.Sequence{space, core.Not{Argument: core.Root{Name: "keyword", Type: "string"}}, core.Regex{Regex: `[a-z][a-z0-9]*`}},
+		Returns:    "string",
+		Expression: "arg.V2",
+	}, Name: "name"})
+	digits := core.Plus{Argument: core.Regex{Regex: `[0-9]`}}
+	state.DefineRoot("number", core.Go{
+		Argument: core.Sequence{space, core.Alias{Argument: core.Try{
+			Argument: core.Contents{Argument: core.Sequence{
+				core.And{Argument: core.Regex{Regex: `[0-9]`}},
+				digits,
+				core.Optional{Argument: core.Sequence{core.Literal("."), digits}},
+				core.Optional{Argument: core.Regex{Regex: `e[0-9]+`}},
+			}},
+			Returns:    "float64",
+			Expression: "strconv.ParseFloat(arg, 64)",
+		}, Name: "number"}},
+		Returns:    "string",
+		Expression: "strconv.FormatFloat(arg.V1, 'g', -1, 64)",
+	})
+	state.DefineRoot("value", core.Memo{Argument: core.Alternate{
+		core.Root{Name: "number", Type: "string"},
+		core.Root{Name: "name", Type: "string"},
+		core.Go{
+			Argument:   core.Sequence{space, core.Literal("("), core.Root{Name: "value", Type: "string"}, space, core.Label{Argument: core.Literal(")"), Message: "unclosed parenthesis", Opening: true}},
+			Returns:    "string",
+			Expression: `"(" + arg.V2 + ")"`,
+		},
+		core.Try{
+			Argument:   core.Sequence{space, core.Literal("!")},
+			Returns:    "string",
+			Expression: `"", errors.New("values can't be shouted")`,
+			Fatal:      true,
+		},
+	}, Enabled: true})
+	value := core.Root{Name: "value", Type: "string"}
+	state.DefineRoot("statement", core.Alternate{
+		core.Go{
+			Argument:   core.Sequence{space, core.Literal("let"), end, core.Cut{}, core.Root{Name: "name", Type: "string"}, space, core.Label{Argument: core.Literal("="), Message: "missing equals sign"}, value},
+			Returns:    "string",
+			Expression: `arg.V4 + "=" + arg.V7`,
+		},
+		core.Go{
+			Argument:   core.Sequence{space, core.Literal("print"), end, core.Cut{}, core.Plus{Argument: value}},
+			Returns:    "string",
+			Expression: `"print " + strings.Join(arg.V4, " ")`,
+		},
+	})
+	state.DefineRoot("Number", core.Root{Name: "number", Type: "string"})
+	state.DefineRoot("Doc", core.Go{
+		Argument: core.Sequence{core.Star{Argument: core.Go{
+			Argument:   core.Sequence{core.Recover{Argument: core.Root{Name: "statement", Type: "string"}, Until: core.Literal(";"), Placeholder: `"?"`}, space, core.Literal(";")},
+			Returns:    "string",
+			Expression: "arg.V0",
+		}}, space},
+		Returns:    "[]string",
+		Expression: "arg.V0",
+	})
+	state.DefineRoot("Items", core.Star{Argument: core.Recover{
+		Argument:    core.Go{Argument: core.Sequence{core.Root{Name: "name", Type: "string"}, space, core.Literal(";")}, Returns: "string", Expression: "arg.V0"},
+		Until:       core.Literal(";"),
+		Placeholder: `"?"`,
+	}})
+	state.DefineRoot("Word", core.Alternate{
+		core.Go{
+			Argument: core.Sequence{
+				core.Not{Argument: core.Sequence{core.Literal("if"), core.Cut{}, core.Literal("(")}},
+				core.And{Argument: core.Sequence{core.Regex{Regex: `[a-z]`}, core.Cut{}, core.Regex{Regex: `[a-z]`}}},
+				core.Regex{Regex: `[a-z]+`},
+			},
+			Returns:    "string",
+			Expression: "arg.V2",
+		},
+		core.Literal("x"),
+	})
+	state.DefineRoot("Letters", core.Star{Argument: core.Alternate{core.Regex{Regex: `b`}, core.Literal("a")}})
+	nest := core.Root{Name: "nest", Type: "string"}
+	state.DefineRoot("nest", core.Alternate{
+		core.Go{Argument: core.Sequence{core.Literal("("), nest, core.Literal(")")}, Returns: "string", Expression: "arg.V1"},
+		core.Go{Argument: core.Sequence{core.Literal("("), nest, core.Literal("]")}, Returns: "string", Expression: "arg.V1"},
+		core.Literal("x"),
+	})
+	state.DefineRoot("Nest", core.Go{
+		Argument:   core.Sequence{core.Not{Argument: core.Sequence{nest, core.Literal("!")}}, nest},
+		Returns:    "string",
+		Expression: "arg.V1",
+	})
+	a, b, c, d, e := core.Literal("a"), core.Literal("b"), core.Literal("c"), core.Literal("d"), core.Literal("e")
+	text := func(peg core.Peg) core.Peg { return core.Contents{Argument: peg} }
+	failures := core.Alternate{}
+	for i, failure := range []core.Peg{
+		core.Sequence{a, b, c},
+		core.Alternate{a, b, core.Regex{Regex: `[0-9]`}},
+		core.Alternate{text(core.Sequence{a, b}), text(core.Sequence{a, c, d}), e},
+		core.Sequence{core.Alternate{a, b}, core.Alternate{text(core.Sequence{c, d}), e}},
+		core.Alternate{core.Sequence{a, core.Alternate{b, c}}, core.Sequence{a, d}},
+		core.Sequence{core.Star{Argument: a}, b},
+		core.Sequence{core.Alternate{text(core.Sequence{a, b, c}), a}, core.Literal("z")},
+		core.Sequence{core.Not{Argument: core.Sequence{a, b}}, a, c},
+		core.Alternate{a, core.Literal("ab")},
+	} {
+		failures = append(failures, text(core.Sequence{core.Literal(strconv.Itoa(i + 1)), failure}))
+	}
+	state.DefineRoot("Farthest", failures)
+	return state
+}
+
+// Interpreter interprets the grammar, with an action for each of its Go and Try
+// expressions.
+func Interpreter() (*core.Interpreter, error) {
+	state := Grammar()
+	interpreter, err := core.NewInterpreter(&state)
+	if err != nil {
+		return nil, err
+	}
+	element := func(i int) func(interface{}) interface{} {
+		return func(arg interface{}) interface{} {
+			return arg.([]interface{})[i]
+		}
+	}
+	interpreter.Actions["arg.V0"] = element(0)
+	interpreter.Actions["arg.V1"] = element(1)
+	interpreter.Actions["arg.V2"] = element(2)
+	interpreter.Actions["strconv.FormatFloat(arg.V1, 'g', -1, 64)"] = func(arg interface{}) interface{} {
+		return strconv.FormatFloat(arg.([]interface{})[1].(float64), 'g', -1, 64)
+	}
+	interpreter.Actions[`"(" + arg.V2 + ")"`] = func(arg interface{}) interface{} {
+		return "(" + arg.([]interface{})[2].(string) + ")"
+	}
+	interpreter.Actions[`arg.V4 + "=" + arg.V7`] = func(arg interface{}) interface{} {
+		values := arg.([]interface{})
+		return values[4].(string) + "=" + values[7].(string)
+	}
+	interpreter.Actions[`"print " + strings.Join(arg.V4, " ")`] = func(arg interface{}) interface{} {
+		words := []string{}
+		for _, word := range arg.([]interface{})[4].([]interface{}) {
+			words = append(words, word.(string))
+		}
+		return "print " + strings.Join(words, " ")
+	}
+	interpreter.Actions[`"?"`] = func(interface{}) interface{} {
+		return "?"
+	}
+	interpreter.Tries["strconv.ParseFloat(arg, 64)"] = func(arg interface{}) (interface{}, error) {
+		return strconv.ParseFloat(arg.(string), 64)
+	}
+	interpreter.Tries[`"", errors.New("values can't be shouted")`] = func(interface{}) (interface{}, error) {
+		return "", errors.New("values can't be shouted")
+	}
+	return interpreter, nil
+}
@@ -0,0 +1,5438 @@
+package dense
+
+import "context"
+import "errors"
+import "io"
+import "regexp"
+import "strconv"
+import "strings"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
+
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
+
+func NewParser() Parser {
+	return Parser{}
+}
+
+// ParseDoc parses the whole input as Doc, in a fresh session.
+func (parser Parser) ParseDoc(input []byte) ([]string, error) {
+	return parser.NewSession(input).Doc()
+}
+
+// ParseDocPrefix parses as much of the input as Doc matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseDocPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).DocPrefix()
+}
+
+// ParseDocContext parses the input as Doc, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseDocContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Doc()
+}
+
+// ParseDocReader parses the input read from the source as Doc, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseDocReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Doc()
+}
+
+// ParseFarthest parses the whole input as Farthest, in a fresh session.
+func (parser Parser) ParseFarthest(input []byte) (string, error) {
+	return parser.NewSession(input).Farthest()
+}
+
+// ParseFarthestPrefix parses as much of the input as Farthest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseFarthestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).FarthestPrefix()
+}
+
+// ParseFarthestContext parses the input as Farthest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseFarthestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Farthest()
+}
+
+// ParseFarthestReader parses the input read from the source as Farthest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseFarthestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Farthest()
+}
+
+// ParseItems parses the whole input as Items, in a fresh session.
+func (parser Parser) ParseItems(input []byte) ([]string, error) {
+	return parser.NewSession(input).Items()
+}
+
+// ParseItemsPrefix parses as much of the input as Items matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseItemsPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).ItemsPrefix()
+}
+
+// ParseItemsContext parses the input as Items, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseItemsContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Items()
+}
+
+// ParseItemsReader parses the input read from the source as Items, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseItemsReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Items()
+}
+
+// ParseLetters parses the whole input as Letters, in a fresh session.
+func (parser Parser) ParseLetters(input []byte) ([]string, error) {
+	return parser.NewSession(input).Letters()
+}
+
+// ParseLettersPrefix parses as much of the input as Letters matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseLettersPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).LettersPrefix()
+}
+
+// ParseLettersContext parses the input as Letters, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseLettersContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Letters()
+}
+
+// ParseLettersReader parses the input read from the source as Letters, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseLettersReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Letters()
+}
+
+// ParseNest parses the whole input as Nest, in a fresh session.
+func (parser Parser) ParseNest(input []byte) (string, error) {
+	return parser.NewSession(input).Nest()
+}
+
+// ParseNestPrefix parses as much of the input as Nest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NestPrefix()
+}
+
+// ParseNestContext parses the input as Nest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Nest()
+}
+
+// ParseNestReader parses the input read from the source as Nest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Nest()
+}
+
+// ParseNumber parses the whole input as Number, in a fresh session.
+func (parser Parser) ParseNumber(input []byte) (string, error) {
+	return parser.NewSession(input).Number()
+}
+
+// ParseNumberPrefix parses as much of the input as Number matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNumberPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NumberPrefix()
+}
+
+// ParseNumberContext parses the input as Number, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNumberContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Number()
+}
+
+// ParseNumberReader parses the input read from the source as Number, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNumberReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Number()
+}
+
+// ParseWord parses the whole input as Word, in a fresh session.
+func (parser Parser) ParseWord(input []byte) (string, error) {
+	return parser.NewSession(input).Word()
+}
+
+// ParseWordPrefix parses as much of the input as Word matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseWordPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).WordPrefix()
+}
+
+// ParseWordContext parses the input as Word, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseWordContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Word()
+}
+
+// ParseWordReader parses the input read from the source as Word, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseWordReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Word()
+}
+
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
+	window   int  // The position of the first slot in each memoization table
+	// Internal memoization tables
+	slotsm_Doc []int32
+	memom_Doc  []struct {
+		result peg.Result
+		value  []string
+		silent bool
+	}
+	slotsm_Doc_go_seq0_star_go_seq0_recover1_lit []int32
+	memom_Doc_go_seq0_star_go_seq0_recover1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Farthest []int32
+	memom_Farthest  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Farthest_alt0_contents_seq1_seq []int32
+	memom_Farthest_alt0_contents_seq1_seq  []struct {
+		result peg.Result
+		value  struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		silent bool
+	}
+	slotsm_Farthest_alt0_contents_seq1_seq1_lit []int32
+	memom_Farthest_alt0_contents_seq1_seq1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Farthest_alt0_contents_seq1_seq2_lit []int32
+	memom_Farthest_alt0_contents_seq1_seq2_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq []int32
+	memom_Farthest_alt2_contents_seq1_alt0_contents_seq  []struct {
+		result peg.Result
+		value  struct {
+			V0 string
+			V1 string
+		}
+		silent bool
+	}
+	slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit []int32
+	memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Farthest_alt2_contents_seq1_alt2_lit []int32
+	memom_Farthest_alt2_contents_seq1_alt2_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Items []int32
+	memom_Items  []struct {
+		result peg.Result
+		value  []string
+		silent bool
+	}
+	slotsm_Letters []int32
+	memom_Letters  []struct {
+		result peg.Result
+		value  []string
+		silent bool
+	}
+	slotsm_Letters_star_alt1_lit []int32
+	memom_Letters_star_alt1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Nest []int32
+	memom_Nest  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Number []int32
+	memom_Number  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Word []int32
+	memom_Word  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Word_alt0_go_seq1_and_seq0_regex []int32
+	memom_Word_alt0_go_seq1_and_seq0_regex  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_Word_alt1_lit []int32
+	memom_Word_alt1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_keyword []int32
+	memom_keyword  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_keyword_go_seq0_alt0_lit []int32
+	memom_keyword_go_seq0_alt0_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_keyword_go_seq0_alt1_lit []int32
+	memom_keyword_go_seq0_alt1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_keyword_go_seq1_not []int32
+	memom_keyword_go_seq1_not  []struct {
+		result peg.Result
+		value  struct{}
+		silent bool
+	}
+	slotsm_name []int32
+	memom_name  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_nest []int32
+	memom_nest  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_number []int32
+	memom_number  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_number_go_seq1_alias_try_contents_seq0_and_regex []int32
+	memom_number_go_seq1_alias_try_contents_seq0_and_regex  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_number_go_seq1_alias_try_contents_seq1_plus []int32
+	memom_number_go_seq1_alias_try_contents_seq1_plus  []struct {
+		result peg.Result
+		value  []string
+		silent bool
+	}
+	slotsm_space []int32
+	memom_space  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_statement []int32
+	memom_statement  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_statement_alt0_go_seq3_cut []int32
+	memom_statement_alt0_go_seq3_cut  []struct {
+		result peg.Result
+		value  struct{}
+		silent bool
+	}
+	slotsm_value []int32
+	memom_value  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_value_alt []int32
+	memom_value_alt  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_value_alt2_go_seq1_lit []int32
+	memom_value_alt2_go_seq1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_value_alt2_go_seq4_label_lit []int32
+	memom_value_alt2_go_seq4_label_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+	slotsm_value_alt3_try_seq1_lit []int32
+	memom_value_alt3_try_seq1_lit  []struct {
+		result peg.Result
+		value  string
+		silent bool
+	}
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	session.window = 0
+	session.slotsm_Doc = session.emptySlots(session.slotsm_Doc)
+	session.memom_Doc = session.memom_Doc[:0]
+	session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit = session.emptySlots(session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit)
+	session.memom_Doc_go_seq0_star_go_seq0_recover1_lit = session.memom_Doc_go_seq0_star_go_seq0_recover1_lit[:0]
+	session.slotsm_Farthest = session.emptySlots(session.slotsm_Farthest)
+	session.memom_Farthest = session.memom_Farthest[:0]
+	session.slotsm_Farthest_alt0_contents_seq1_seq = session.emptySlots(session.slotsm_Farthest_alt0_contents_seq1_seq)
+	session.memom_Farthest_alt0_contents_seq1_seq = session.memom_Farthest_alt0_contents_seq1_seq[:0]
+	session.slotsm_Farthest_alt0_contents_seq1_seq1_lit = session.emptySlots(session.slotsm_Farthest_alt0_contents_seq1_seq1_lit)
+	session.memom_Farthest_alt0_contents_seq1_seq1_lit = session.memom_Farthest_alt0_contents_seq1_seq1_lit[:0]
+	session.slotsm_Farthest_alt0_contents_seq1_seq2_lit = session.emptySlots(session.slotsm_Farthest_alt0_contents_seq1_seq2_lit)
+	session.memom_Farthest_alt0_contents_seq1_seq2_lit = session.memom_Farthest_alt0_contents_seq1_seq2_lit[:0]
+	session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq = session.emptySlots(session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq)
+	session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq = session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq[:0]
+	session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = session.emptySlots(session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit)
+	session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[:0]
+	session.slotsm_Farthest_alt2_contents_seq1_alt2_lit = session.emptySlots(session.slotsm_Farthest_alt2_contents_seq1_alt2_lit)
+	session.memom_Farthest_alt2_contents_seq1_alt2_lit = session.memom_Farthest_alt2_contents_seq1_alt2_lit[:0]
+	session.slotsm_Items = session.emptySlots(session.slotsm_Items)
+	session.memom_Items = session.memom_Items[:0]
+	session.slotsm_Letters = session.emptySlots(session.slotsm_Letters)
+	session.memom_Letters = session.memom_Letters[:0]
+	session.slotsm_Letters_star_alt1_lit = session.emptySlots(session.slotsm_Letters_star_alt1_lit)
+	session.memom_Letters_star_alt1_lit = session.memom_Letters_star_alt1_lit[:0]
+	session.slotsm_Nest = session.emptySlots(session.slotsm_Nest)
+	session.memom_Nest = session.memom_Nest[:0]
+	session.slotsm_Number = session.emptySlots(session.slotsm_Number)
+	session.memom_Number = session.memom_Number[:0]
+	session.slotsm_Word = session.emptySlots(session.slotsm_Word)
+	session.memom_Word = session.memom_Word[:0]
+	session.slotsm_Word_alt0_go_seq1_and_seq0_regex = session.emptySlots(session.slotsm_Word_alt0_go_seq1_and_seq0_regex)
+	session.memom_Word_alt0_go_seq1_and_seq0_regex = session.memom_Word_alt0_go_seq1_and_seq0_regex[:0]
+	session.slotsm_Word_alt1_lit = session.emptySlots(session.slotsm_Word_alt1_lit)
+	session.memom_Word_alt1_lit = session.memom_Word_alt1_lit[:0]
+	session.slotsm_keyword = session.emptySlots(session.slotsm_keyword)
+	session.memom_keyword = session.memom_keyword[:0]
+	session.slotsm_keyword_go_seq0_alt0_lit = session.emptySlots(session.slotsm_keyword_go_seq0_alt0_lit)
+	session.memom_keyword_go_seq0_alt0_lit = session.memom_keyword_go_seq0_alt0_lit[:0]
+	session.slotsm_keyword_go_seq0_alt1_lit = session.emptySlots(session.slotsm_keyword_go_seq0_alt1_lit)
+	session.memom_keyword_go_seq0_alt1_lit = session.memom_keyword_go_seq0_alt1_lit[:0]
+	session.slotsm_keyword_go_seq1_not = session.emptySlots(session.slotsm_keyword_go_seq1_not)
+	session.memom_keyword_go_seq1_not = session.memom_keyword_go_seq1_not[:0]
+	session.slotsm_name = session.emptySlots(session.slotsm_name)
+	session.memom_name = session.memom_name[:0]
+	session.slotsm_nest = session.emptySlots(session.slotsm_nest)
+	session.memom_nest = session.memom_nest[:0]
+	session.slotsm_number = session.emptySlots(session.slotsm_number)
+	session.memom_number = session.memom_number[:0]
+	session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex = session.emptySlots(session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex)
+	session.memom_number_go_seq1_alias_try_contents_seq0_and_regex = session.memom_number_go_seq1_alias_try_contents_seq0_and_regex[:0]
+	session.slotsm_number_go_seq1_alias_try_contents_seq1_plus = session.emptySlots(session.slotsm_number_go_seq1_alias_try_contents_seq1_plus)
+	session.memom_number_go_seq1_alias_try_contents_seq1_plus = session.memom_number_go_seq1_alias_try_contents_seq1_plus[:0]
+	session.slotsm_space = session.emptySlots(session.slotsm_space)
+	session.memom_space = session.memom_space[:0]
+	session.slotsm_statement = session.emptySlots(session.slotsm_statement)
+	session.memom_statement = session.memom_statement[:0]
+	session.slotsm_statement_alt0_go_seq3_cut = session.emptySlots(session.slotsm_statement_alt0_go_seq3_cut)
+	session.memom_statement_alt0_go_seq3_cut = session.memom_statement_alt0_go_seq3_cut[:0]
+	session.slotsm_value = session.emptySlots(session.slotsm_value)
+	session.memom_value = session.memom_value[:0]
+	session.slotsm_value_alt = session.emptySlots(session.slotsm_value_alt)
+	session.memom_value_alt = session.memom_value_alt[:0]
+	session.slotsm_value_alt2_go_seq1_lit = session.emptySlots(session.slotsm_value_alt2_go_seq1_lit)
+	session.memom_value_alt2_go_seq1_lit = session.memom_value_alt2_go_seq1_lit[:0]
+	session.slotsm_value_alt2_go_seq4_label_lit = session.emptySlots(session.slotsm_value_alt2_go_seq4_label_lit)
+	session.memom_value_alt2_go_seq4_label_lit = session.memom_value_alt2_go_seq4_label_lit[:0]
+	session.slotsm_value_alt3_try_seq1_lit = session.emptySlots(session.slotsm_value_alt3_try_seq1_lit)
+	session.memom_value_alt3_try_seq1_lit = session.memom_value_alt3_try_seq1_lit[:0]
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	if before < session.window {
+		before = session.window
+	}
+	session.memos = 0
+	keptm_Doc := session.memom_Doc
+	session.memom_Doc = nil
+	for slot := range session.slotsm_Doc {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Doc) && session.slotsm_Doc[from] != 0 {
+			session.memom_Doc = append(session.memom_Doc, keptm_Doc[session.slotsm_Doc[from]-1])
+			index = int32(len(session.memom_Doc))
+		}
+		session.slotsm_Doc[slot] = index
+	}
+	session.memos += len(session.memom_Doc)
+	keptm_Doc_go_seq0_star_go_seq0_recover1_lit := session.memom_Doc_go_seq0_star_go_seq0_recover1_lit
+	session.memom_Doc_go_seq0_star_go_seq0_recover1_lit = nil
+	for slot := range session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit) && session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit[from] != 0 {
+			session.memom_Doc_go_seq0_star_go_seq0_recover1_lit = append(session.memom_Doc_go_seq0_star_go_seq0_recover1_lit, keptm_Doc_go_seq0_star_go_seq0_recover1_lit[session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit[from]-1])
+			index = int32(len(session.memom_Doc_go_seq0_star_go_seq0_recover1_lit))
+		}
+		session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit[slot] = index
+	}
+	session.memos += len(session.memom_Doc_go_seq0_star_go_seq0_recover1_lit)
+	keptm_Farthest := session.memom_Farthest
+	session.memom_Farthest = nil
+	for slot := range session.slotsm_Farthest {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest) && session.slotsm_Farthest[from] != 0 {
+			session.memom_Farthest = append(session.memom_Farthest, keptm_Farthest[session.slotsm_Farthest[from]-1])
+			index = int32(len(session.memom_Farthest))
+		}
+		session.slotsm_Farthest[slot] = index
+	}
+	session.memos += len(session.memom_Farthest)
+	keptm_Farthest_alt0_contents_seq1_seq := session.memom_Farthest_alt0_contents_seq1_seq
+	session.memom_Farthest_alt0_contents_seq1_seq = nil
+	for slot := range session.slotsm_Farthest_alt0_contents_seq1_seq {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt0_contents_seq1_seq) && session.slotsm_Farthest_alt0_contents_seq1_seq[from] != 0 {
+			session.memom_Farthest_alt0_contents_seq1_seq = append(session.memom_Farthest_alt0_contents_seq1_seq, keptm_Farthest_alt0_contents_seq1_seq[session.slotsm_Farthest_alt0_contents_seq1_seq[from]-1])
+			index = int32(len(session.memom_Farthest_alt0_contents_seq1_seq))
+		}
+		session.slotsm_Farthest_alt0_contents_seq1_seq[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt0_contents_seq1_seq)
+	keptm_Farthest_alt0_contents_seq1_seq1_lit := session.memom_Farthest_alt0_contents_seq1_seq1_lit
+	session.memom_Farthest_alt0_contents_seq1_seq1_lit = nil
+	for slot := range session.slotsm_Farthest_alt0_contents_seq1_seq1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt0_contents_seq1_seq1_lit) && session.slotsm_Farthest_alt0_contents_seq1_seq1_lit[from] != 0 {
+			session.memom_Farthest_alt0_contents_seq1_seq1_lit = append(session.memom_Farthest_alt0_contents_seq1_seq1_lit, keptm_Farthest_alt0_contents_seq1_seq1_lit[session.slotsm_Farthest_alt0_contents_seq1_seq1_lit[from]-1])
+			index = int32(len(session.memom_Farthest_alt0_contents_seq1_seq1_lit))
+		}
+		session.slotsm_Farthest_alt0_contents_seq1_seq1_lit[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt0_contents_seq1_seq1_lit)
+	keptm_Farthest_alt0_contents_seq1_seq2_lit := session.memom_Farthest_alt0_contents_seq1_seq2_lit
+	session.memom_Farthest_alt0_contents_seq1_seq2_lit = nil
+	for slot := range session.slotsm_Farthest_alt0_contents_seq1_seq2_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt0_contents_seq1_seq2_lit) && session.slotsm_Farthest_alt0_contents_seq1_seq2_lit[from] != 0 {
+			session.memom_Farthest_alt0_contents_seq1_seq2_lit = append(session.memom_Farthest_alt0_contents_seq1_seq2_lit, keptm_Farthest_alt0_contents_seq1_seq2_lit[session.slotsm_Farthest_alt0_contents_seq1_seq2_lit[from]-1])
+			index = int32(len(session.memom_Farthest_alt0_contents_seq1_seq2_lit))
+		}
+		session.slotsm_Farthest_alt0_contents_seq1_seq2_lit[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt0_contents_seq1_seq2_lit)
+	keptm_Farthest_alt2_contents_seq1_alt0_contents_seq := session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq
+	session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq = nil
+	for slot := range session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq) && session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq[from] != 0 {
+			session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq = append(session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq, keptm_Farthest_alt2_contents_seq1_alt0_contents_seq[session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq[from]-1])
+			index = int32(len(session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq))
+		}
+		session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq)
+	keptm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit := session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit
+	session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = nil
+	for slot := range session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit) && session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[from] != 0 {
+			session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = append(session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, keptm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[from]-1])
+			index = int32(len(session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit))
+		}
+		session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit)
+	keptm_Farthest_alt2_contents_seq1_alt2_lit := session.memom_Farthest_alt2_contents_seq1_alt2_lit
+	session.memom_Farthest_alt2_contents_seq1_alt2_lit = nil
+	for slot := range session.slotsm_Farthest_alt2_contents_seq1_alt2_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Farthest_alt2_contents_seq1_alt2_lit) && session.slotsm_Farthest_alt2_contents_seq1_alt2_lit[from] != 0 {
+			session.memom_Farthest_alt2_contents_seq1_alt2_lit = append(session.memom_Farthest_alt2_contents_seq1_alt2_lit, keptm_Farthest_alt2_contents_seq1_alt2_lit[session.slotsm_Farthest_alt2_contents_seq1_alt2_lit[from]-1])
+			index = int32(len(session.memom_Farthest_alt2_contents_seq1_alt2_lit))
+		}
+		session.slotsm_Farthest_alt2_contents_seq1_alt2_lit[slot] = index
+	}
+	session.memos += len(session.memom_Farthest_alt2_contents_seq1_alt2_lit)
+	keptm_Items := session.memom_Items
+	session.memom_Items = nil
+	for slot := range session.slotsm_Items {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Items) && session.slotsm_Items[from] != 0 {
+			session.memom_Items = append(session.memom_Items, keptm_Items[session.slotsm_Items[from]-1])
+			index = int32(len(session.memom_Items))
+		}
+		session.slotsm_Items[slot] = index
+	}
+	session.memos += len(session.memom_Items)
+	keptm_Letters := session.memom_Letters
+	session.memom_Letters = nil
+	for slot := range session.slotsm_Letters {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Letters) && session.slotsm_Letters[from] != 0 {
+			session.memom_Letters = append(session.memom_Letters, keptm_Letters[session.slotsm_Letters[from]-1])
+			index = int32(len(session.memom_Letters))
+		}
+		session.slotsm_Letters[slot] = index
+	}
+	session.memos += len(session.memom_Letters)
+	keptm_Letters_star_alt1_lit := session.memom_Letters_star_alt1_lit
+	session.memom_Letters_star_alt1_lit = nil
+	for slot := range session.slotsm_Letters_star_alt1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Letters_star_alt1_lit) && session.slotsm_Letters_star_alt1_lit[from] != 0 {
+			session.memom_Letters_star_alt1_lit = append(session.memom_Letters_star_alt1_lit, keptm_Letters_star_alt1_lit[session.slotsm_Letters_star_alt1_lit[from]-1])
+			index = int32(len(session.memom_Letters_star_alt1_lit))
+		}
+		session.slotsm_Letters_star_alt1_lit[slot] = index
+	}
+	session.memos += len(session.memom_Letters_star_alt1_lit)
+	keptm_Nest := session.memom_Nest
+	session.memom_Nest = nil
+	for slot := range session.slotsm_Nest {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Nest) && session.slotsm_Nest[from] != 0 {
+			session.memom_Nest = append(session.memom_Nest, keptm_Nest[session.slotsm_Nest[from]-1])
+			index = int32(len(session.memom_Nest))
+		}
+		session.slotsm_Nest[slot] = index
+	}
+	session.memos += len(session.memom_Nest)
+	keptm_Number := session.memom_Number
+	session.memom_Number = nil
+	for slot := range session.slotsm_Number {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Number) && session.slotsm_Number[from] != 0 {
+			session.memom_Number = append(session.memom_Number, keptm_Number[session.slotsm_Number[from]-1])
+			index = int32(len(session.memom_Number))
+		}
+		session.slotsm_Number[slot] = index
+	}
+	session.memos += len(session.memom_Number)
+	keptm_Word := session.memom_Word
+	session.memom_Word = nil
+	for slot := range session.slotsm_Word {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Word) && session.slotsm_Word[from] != 0 {
+			session.memom_Word = append(session.memom_Word, keptm_Word[session.slotsm_Word[from]-1])
+			index = int32(len(session.memom_Word))
+		}
+		session.slotsm_Word[slot] = index
+	}
+	session.memos += len(session.memom_Word)
+	keptm_Word_alt0_go_seq1_and_seq0_regex := session.memom_Word_alt0_go_seq1_and_seq0_regex
+	session.memom_Word_alt0_go_seq1_and_seq0_regex = nil
+	for slot := range session.slotsm_Word_alt0_go_seq1_and_seq0_regex {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Word_alt0_go_seq1_and_seq0_regex) && session.slotsm_Word_alt0_go_seq1_and_seq0_regex[from] != 0 {
+			session.memom_Word_alt0_go_seq1_and_seq0_regex = append(session.memom_Word_alt0_go_seq1_and_seq0_regex, keptm_Word_alt0_go_seq1_and_seq0_regex[session.slotsm_Word_alt0_go_seq1_and_seq0_regex[from]-1])
+			index = int32(len(session.memom_Word_alt0_go_seq1_and_seq0_regex))
+		}
+		session.slotsm_Word_alt0_go_seq1_and_seq0_regex[slot] = index
+	}
+	session.memos += len(session.memom_Word_alt0_go_seq1_and_seq0_regex)
+	keptm_Word_alt1_lit := session.memom_Word_alt1_lit
+	session.memom_Word_alt1_lit = nil
+	for slot := range session.slotsm_Word_alt1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_Word_alt1_lit) && session.slotsm_Word_alt1_lit[from] != 0 {
+			session.memom_Word_alt1_lit = append(session.memom_Word_alt1_lit, keptm_Word_alt1_lit[session.slotsm_Word_alt1_lit[from]-1])
+			index = int32(len(session.memom_Word_alt1_lit))
+		}
+		session.slotsm_Word_alt1_lit[slot] = index
+	}
+	session.memos += len(session.memom_Word_alt1_lit)
+	keptm_keyword := session.memom_keyword
+	session.memom_keyword = nil
+	for slot := range session.slotsm_keyword {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_keyword) && session.slotsm_keyword[from] != 0 {
+			session.memom_keyword = append(session.memom_keyword, keptm_keyword[session.slotsm_keyword[from]-1])
+			index = int32(len(session.memom_keyword))
+		}
+		session.slotsm_keyword[slot] = index
+	}
+	session.memos += len(session.memom_keyword)
+	keptm_keyword_go_seq0_alt0_lit := session.memom_keyword_go_seq0_alt0_lit
+	session.memom_keyword_go_seq0_alt0_lit = nil
+	for slot := range session.slotsm_keyword_go_seq0_alt0_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_keyword_go_seq0_alt0_lit) && session.slotsm_keyword_go_seq0_alt0_lit[from] != 0 {
+			session.memom_keyword_go_seq0_alt0_lit = append(session.memom_keyword_go_seq0_alt0_lit, keptm_keyword_go_seq0_alt0_lit[session.slotsm_keyword_go_seq0_alt0_lit[from]-1])
+			index = int32(len(session.memom_keyword_go_seq0_alt0_lit))
+		}
+		session.slotsm_keyword_go_seq0_alt0_lit[slot] = index
+	}
+	session.memos += len(session.memom_keyword_go_seq0_alt0_lit)
+	keptm_keyword_go_seq0_alt1_lit := session.memom_keyword_go_seq0_alt1_lit
+	session.memom_keyword_go_seq0_alt1_lit = nil
+	for slot := range session.slotsm_keyword_go_seq0_alt1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_keyword_go_seq0_alt1_lit) && session.slotsm_keyword_go_seq0_alt1_lit[from] != 0 {
+			session.memom_keyword_go_seq0_alt1_lit = append(session.memom_keyword_go_seq0_alt1_lit, keptm_keyword_go_seq0_alt1_lit[session.slotsm_keyword_go_seq0_alt1_lit[from]-1])
+			index = int32(len(session.memom_keyword_go_seq0_alt1_lit))
+		}
+		session.slotsm_keyword_go_seq0_alt1_lit[slot] = index
+	}
+	session.memos += len(session.memom_keyword_go_seq0_alt1_lit)
+	keptm_keyword_go_seq1_not := session.memom_keyword_go_seq1_not
+	session.memom_keyword_go_seq1_not = nil
+	for slot := range session.slotsm_keyword_go_seq1_not {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_keyword_go_seq1_not) && session.slotsm_keyword_go_seq1_not[from] != 0 {
+			session.memom_keyword_go_seq1_not = append(session.memom_keyword_go_seq1_not, keptm_keyword_go_seq1_not[session.slotsm_keyword_go_seq1_not[from]-1])
+			index = int32(len(session.memom_keyword_go_seq1_not))
+		}
+		session.slotsm_keyword_go_seq1_not[slot] = index
+	}
+	session.memos += len(session.memom_keyword_go_seq1_not)
+	keptm_name := session.memom_name
+	session.memom_name = nil
+	for slot := range session.slotsm_name {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_name) && session.slotsm_name[from] != 0 {
+			session.memom_name = append(session.memom_name, keptm_name[session.slotsm_name[from]-1])
+			index = int32(len(session.memom_name))
+		}
+		session.slotsm_name[slot] = index
+	}
+	session.memos += len(session.memom_name)
+	keptm_nest := session.memom_nest
+	session.memom_nest = nil
+	for slot := range session.slotsm_nest {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_nest) && session.slotsm_nest[from] != 0 {
+			session.memom_nest = append(session.memom_nest, keptm_nest[session.slotsm_nest[from]-1])
+			index = int32(len(session.memom_nest))
+		}
+		session.slotsm_nest[slot] = index
+	}
+	session.memos += len(session.memom_nest)
+	keptm_number := session.memom_number
+	session.memom_number = nil
+	for slot := range session.slotsm_number {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_number) && session.slotsm_number[from] != 0 {
+			session.memom_number = append(session.memom_number, keptm_number[session.slotsm_number[from]-1])
+			index = int32(len(session.memom_number))
+		}
+		session.slotsm_number[slot] = index
+	}
+	session.memos += len(session.memom_number)
+	keptm_number_go_seq1_alias_try_contents_seq0_and_regex := session.memom_number_go_seq1_alias_try_contents_seq0_and_regex
+	session.memom_number_go_seq1_alias_try_contents_seq0_and_regex = nil
+	for slot := range session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex) && session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex[from] != 0 {
+			session.memom_number_go_seq1_alias_try_contents_seq0_and_regex = append(session.memom_number_go_seq1_alias_try_contents_seq0_and_regex, keptm_number_go_seq1_alias_try_contents_seq0_and_regex[session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex[from]-1])
+			index = int32(len(session.memom_number_go_seq1_alias_try_contents_seq0_and_regex))
+		}
+		session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex[slot] = index
+	}
+	session.memos += len(session.memom_number_go_seq1_alias_try_contents_seq0_and_regex)
+	keptm_number_go_seq1_alias_try_contents_seq1_plus := session.memom_number_go_seq1_alias_try_contents_seq1_plus
+	session.memom_number_go_seq1_alias_try_contents_seq1_plus = nil
+	for slot := range session.slotsm_number_go_seq1_alias_try_contents_seq1_plus {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_number_go_seq1_alias_try_contents_seq1_plus) && session.slotsm_number_go_seq1_alias_try_contents_seq1_plus[from] != 0 {
+			session.memom_number_go_seq1_alias_try_contents_seq1_plus = append(session.memom_number_go_seq1_alias_try_contents_seq1_plus, keptm_number_go_seq1_alias_try_contents_seq1_plus[session.slotsm_number_go_seq1_alias_try_contents_seq1_plus[from]-1])
+			index = int32(len(session.memom_number_go_seq1_alias_try_contents_seq1_plus))
+		}
+		session.slotsm_number_go_seq1_alias_try_contents_seq1_plus[slot] = index
+	}
+	session.memos += len(session.memom_number_go_seq1_alias_try_contents_seq1_plus)
+	keptm_space := session.memom_space
+	session.memom_space = nil
+	for slot := range session.slotsm_space {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_space) && session.slotsm_space[from] != 0 {
+			session.memom_space = append(session.memom_space, keptm_space[session.slotsm_space[from]-1])
+			index = int32(len(session.memom_space))
+		}
+		session.slotsm_space[slot] = index
+	}
+	session.memos += len(session.memom_space)
+	keptm_statement := session.memom_statement
+	session.memom_statement = nil
+	for slot := range session.slotsm_statement {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_statement) && session.slotsm_statement[from] != 0 {
+			session.memom_statement = append(session.memom_statement, keptm_statement[session.slotsm_statement[from]-1])
+			index = int32(len(session.memom_statement))
+		}
+		session.slotsm_statement[slot] = index
+	}
+	session.memos += len(session.memom_statement)
+	keptm_statement_alt0_go_seq3_cut := session.memom_statement_alt0_go_seq3_cut
+	session.memom_statement_alt0_go_seq3_cut = nil
+	for slot := range session.slotsm_statement_alt0_go_seq3_cut {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_statement_alt0_go_seq3_cut) && session.slotsm_statement_alt0_go_seq3_cut[from] != 0 {
+			session.memom_statement_alt0_go_seq3_cut = append(session.memom_statement_alt0_go_seq3_cut, keptm_statement_alt0_go_seq3_cut[session.slotsm_statement_alt0_go_seq3_cut[from]-1])
+			index = int32(len(session.memom_statement_alt0_go_seq3_cut))
+		}
+		session.slotsm_statement_alt0_go_seq3_cut[slot] = index
+	}
+	session.memos += len(session.memom_statement_alt0_go_seq3_cut)
+	keptm_value := session.memom_value
+	session.memom_value = nil
+	for slot := range session.slotsm_value {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_value) && session.slotsm_value[from] != 0 {
+			session.memom_value = append(session.memom_value, keptm_value[session.slotsm_value[from]-1])
+			index = int32(len(session.memom_value))
+		}
+		session.slotsm_value[slot] = index
+	}
+	session.memos += len(session.memom_value)
+	keptm_value_alt := session.memom_value_alt
+	session.memom_value_alt = nil
+	for slot := range session.slotsm_value_alt {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_value_alt) && session.slotsm_value_alt[from] != 0 {
+			session.memom_value_alt = append(session.memom_value_alt, keptm_value_alt[session.slotsm_value_alt[from]-1])
+			index = int32(len(session.memom_value_alt))
+		}
+		session.slotsm_value_alt[slot] = index
+	}
+	session.memos += len(session.memom_value_alt)
+	keptm_value_alt2_go_seq1_lit := session.memom_value_alt2_go_seq1_lit
+	session.memom_value_alt2_go_seq1_lit = nil
+	for slot := range session.slotsm_value_alt2_go_seq1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_value_alt2_go_seq1_lit) && session.slotsm_value_alt2_go_seq1_lit[from] != 0 {
+			session.memom_value_alt2_go_seq1_lit = append(session.memom_value_alt2_go_seq1_lit, keptm_value_alt2_go_seq1_lit[session.slotsm_value_alt2_go_seq1_lit[from]-1])
+			index = int32(len(session.memom_value_alt2_go_seq1_lit))
+		}
+		session.slotsm_value_alt2_go_seq1_lit[slot] = index
+	}
+	session.memos += len(session.memom_value_alt2_go_seq1_lit)
+	keptm_value_alt2_go_seq4_label_lit := session.memom_value_alt2_go_seq4_label_lit
+	session.memom_value_alt2_go_seq4_label_lit = nil
+	for slot := range session.slotsm_value_alt2_go_seq4_label_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_value_alt2_go_seq4_label_lit) && session.slotsm_value_alt2_go_seq4_label_lit[from] != 0 {
+			session.memom_value_alt2_go_seq4_label_lit = append(session.memom_value_alt2_go_seq4_label_lit, keptm_value_alt2_go_seq4_label_lit[session.slotsm_value_alt2_go_seq4_label_lit[from]-1])
+			index = int32(len(session.memom_value_alt2_go_seq4_label_lit))
+		}
+		session.slotsm_value_alt2_go_seq4_label_lit[slot] = index
+	}
+	session.memos += len(session.memom_value_alt2_go_seq4_label_lit)
+	keptm_value_alt3_try_seq1_lit := session.memom_value_alt3_try_seq1_lit
+	session.memom_value_alt3_try_seq1_lit = nil
+	for slot := range session.slotsm_value_alt3_try_seq1_lit {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slotsm_value_alt3_try_seq1_lit) && session.slotsm_value_alt3_try_seq1_lit[from] != 0 {
+			session.memom_value_alt3_try_seq1_lit = append(session.memom_value_alt3_try_seq1_lit, keptm_value_alt3_try_seq1_lit[session.slotsm_value_alt3_try_seq1_lit[from]-1])
+			index = int32(len(session.memom_value_alt3_try_seq1_lit))
+		}
+		session.slotsm_value_alt3_try_seq1_lit[slot] = index
+	}
+	session.memos += len(session.memom_value_alt3_try_seq1_lit)
+	session.window = before
+}
+
+// emptySlots returns a memoization table with an empty slot for each position
+// of the input read so far, reusing the memory of the given one. A slot holds
+// the index of the result memoized at its position plus one, or else zero.
+func (session *Session) emptySlots(table []int32) []int32 {
+	size := len(session.input) + 1
+	if cap(table) < size {
+		return make([]int32, size)
+	}
+	table = table[:size]
+	for slot := range table {
+		table[slot] = 0
+	}
+	return table
+}
+
+// slot is the slot of the table for the given position, which must not be
+// before the window. The table grows to hold it, if the input was streamed past
+// the end of the table.
+func (session *Session) slot(table *[]int32, here int) *int32 {
+	slot := here - session.window
+	if slot >= len(*table) {
+		grown := make([]int32, 2*slot+1)
+		copy(grown, *table)
+		*table = grown
+	}
+	return &(*table)[slot]
+}
+
+// Doc parses the whole input as Doc. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Doc() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Doc(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// DocPrefix parses as much of the input as Doc matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Doc.
+func (session *Session) DocPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Doc(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Farthest parses the whole input as Farthest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Farthest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Farthest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// FarthestPrefix parses as much of the input as Farthest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Farthest.
+func (session *Session) FarthestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Farthest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Items parses the whole input as Items. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Items() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Items(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ItemsPrefix parses as much of the input as Items matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Items.
+func (session *Session) ItemsPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Items(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Letters parses the whole input as Letters. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Letters() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Letters(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// LettersPrefix parses as much of the input as Letters matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Letters.
+func (session *Session) LettersPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Letters(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Nest parses the whole input as Nest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Nest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Nest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NestPrefix parses as much of the input as Nest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Nest.
+func (session *Session) NestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Nest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Number parses the whole input as Number. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Number() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Number(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NumberPrefix parses as much of the input as Number matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Number.
+func (session *Session) NumberPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Number(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Word parses the whole input as Word. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Word() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Word(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// WordPrefix parses as much of the input as Word matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Word.
+func (session *Session) WordPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Word(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+var resourcem_Letters_star_alt0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:b)")
+var resourcem_Word_alt0_go_seq1_and_seq0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z])")
+var resourcem_Word_alt0_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z]+)")
+var resourcem_keyword_go_seq1_not_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z0-9])")
+var resourcem_name_alias_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z][a-z0-9]*)")
+var resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[0-9])")
+var resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:e[0-9]+)")
+var resourcem_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[ \\t\\r\\n]*)")
+
+// Below is the internal generated parse structure.
+// It's not very efficient right now, but is accomplishes parsing in linear time.
+
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
+}
+
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
+}
+
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
+	}
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
+	}
+	return nil
+}
+
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
+}
+
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
+}
+
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
+	}
+	return here
+}
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
+	}
+	return session.fill(here, to)
+}
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
+	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
+}
+
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
+}
+
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
+}
+
+func (session *Session) m_Doc(here int) (peg.Result, []string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Doc) && session.slotsm_Doc[slot] != 0 {
+		if memo := &session.memom_Doc[session.slotsm_Doc[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Doc = append(session.memom_Doc, struct {
+			result peg.Result
+			value  []string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Doc, here) = int32(len(session.memom_Doc))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Doc
+func (session *Session) dm_Doc(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 []string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 []string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, []string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := []string{}
+				var recovered []*peg.ParseError
+				for {
+					next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						check, value := func(here int) (peg.Result, struct {
+							V0 string
+							V1 string
+							V2 string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := session.m_statement(here)
+								if check.Ok || session.failures.Silent != 0 {
+									return check, value
+								}
+								recovered := session.failure(check)
+								session.failures.Clear()
+								at := here
+								for session.available(here, at+1) {
+									session.failures.Silent++
+									sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+									session.failures.Silent--
+									if sync.Ok {
+										break
+									}
+									at = session.next(at)
+								}
+								var placeholder string = "?"
+								return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_space(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if !check.Ok {
+							var zero string
+							return check, zero
+						}
+						answer := func(arg struct {
+							V0 string
+							V1 string
+							V2 string
+						}) string {
+							return arg.V0
+						}(value)
+						return check, answer
+					}(here)
+					if next.Fatal {
+						return next, nil
+					}
+					if !next.Ok {
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					if next.At == here {
+						session.failures.Discard(next.Recovered)
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					here = next.At
+					session.advance(mark, here)
+					recovered = append(recovered, next.Recovered...)
+					result = append(result, value)
+				}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero []string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 []string
+			V1 string
+		}) []string {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit) && session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit[slot] != 0 {
+		if memo := &session.memom_Doc_go_seq0_star_go_seq0_recover1_lit[session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go_seq0_recover1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Doc_go_seq0_star_go_seq0_recover1_lit = append(session.memom_Doc_go_seq0_star_go_seq0_recover1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Doc_go_seq0_star_go_seq0_recover1_lit, here) = int32(len(session.memom_Doc_go_seq0_star_go_seq0_recover1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// ";"
+func (session *Session) dm_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ";" {
+		return session.failures.Fail(here, peg.Expected{Token: ";"}), ""
+	}
+	return peg.Success(here + 1), ";"
+}
+
+func (session *Session) m_Farthest(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest) && session.slotsm_Farthest[slot] != 0 {
+		if memo := &session.memom_Farthest[session.slotsm_Farthest[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest = append(session.memom_Farthest, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest, here) = int32(len(session.memom_Farthest))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Farthest
+func (session *Session) dm_Farthest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+					V2 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+						V2 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "1" {
+						return session.failures.Fail(here, peg.Expected{Token: "1"}), ""
+					}
+					return peg.Success(here + 1), "1"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				if next, value := session.m_Farthest_alt0_contents_seq1_seq(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "2" {
+						return session.failures.Fail(here, peg.Expected{Token: "2"}), ""
+					}
+					return peg.Success(here + 1), "2"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "3" {
+						return session.failures.Fail(here, peg.Expected{Token: "3"}), ""
+					}
+					return peg.Success(here + 1), "3"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := func(here int) (peg.Result, struct {
+							V0 string
+							V1 string
+							V2 string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "4" {
+						return session.failures.Fail(here, peg.Expected{Token: "4"}), ""
+					}
+					return peg.Success(here + 1), "4"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							check, _ := func(here int) (peg.Result, struct {
+								V0 string
+								V1 string
+							}) {
+								session.enter(here)
+								defer session.leave()
+								result := struct {
+									V0 string
+									V1 string
+								}{}
+								var recovered []*peg.ParseError
+								if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+									here = next.At
+									recovered = append(recovered, next.Recovered...)
+									result.V0 = value
+								} else {
+									return next, struct {
+										V0 string
+										V1 string
+									}{}
+								}
+								if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+									here = next.At
+									recovered = append(recovered, next.Recovered...)
+									result.V1 = value
+								} else {
+									return next, struct {
+										V0 string
+										V1 string
+									}{}
+								}
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}(here)
+							if check.Ok {
+								return check, string(session.slice(here, check.At))
+							}
+							return check, ""
+
+						}(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "5" {
+						return session.failures.Fail(here, peg.Expected{Token: "5"}), ""
+					}
+					return peg.Success(here + 1), "5"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+					if next, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							failed := peg.Result{At: here}
+
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							var zero string
+							return failed, zero
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero struct {
+						V0 string
+						V1 string
+					}
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 []string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 []string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "6" {
+						return session.failures.Fail(here, peg.Expected{Token: "6"}), ""
+					}
+					return peg.Success(here + 1), "6"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 []string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 []string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 []string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, []string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						result := []string{}
+						var recovered []*peg.ParseError
+						for {
+							next, value := session.m_Letters_star_alt1_lit(here)
+							if next.Fatal {
+								return next, nil
+							}
+							if !next.Ok {
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}
+							if next.At == here {
+								session.failures.Discard(next.Recovered)
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}
+							here = next.At
+							session.advance(mark, here)
+							recovered = append(recovered, next.Recovered...)
+							result = append(result, value)
+						}
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 []string
+							V1 string
+						}{}
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 []string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 []string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "7" {
+						return session.failures.Fail(here, peg.Expected{Token: "7"}), ""
+					}
+					return peg.Success(here + 1), "7"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							check, _ := session.m_Farthest_alt0_contents_seq1_seq(here)
+							if check.Ok {
+								return check, string(session.slice(here, check.At))
+							}
+							return check, ""
+
+						}(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+1) || string(session.slice(here, here+1)) != "z" {
+							return session.failures.Fail(here, peg.Expected{Token: "z"}), ""
+						}
+						return peg.Success(here + 1), "z"
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 struct{}
+					V1 string
+					V2 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 struct{}
+						V1 string
+						V2 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "8" {
+						return session.failures.Fail(here, peg.Expected{Token: "8"}), ""
+					}
+					return peg.Success(here + 1), "8"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 struct{}
+					V1 string
+					V2 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 struct{}
+						V1 string
+						V2 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, struct{}) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						session.failures.Silent++
+						check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+						session.failures.Silent--
+						if !check.Ok {
+							return peg.Success(here), struct{}{}
+						}
+						return session.failures.Fail(here, peg.Exclude{Message: "\"a\" then \"b\""}), struct{}{}
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V2 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "9" {
+						return session.failures.Fail(here, peg.Expected{Token: "9"}), ""
+					}
+					return peg.Success(here + 1), "9"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+2) || string(session.slice(here, here+2)) != "ab" {
+							return session.failures.Fail(here, peg.Expected{Token: "ab"}), ""
+						}
+						return peg.Success(here + 2), "ab"
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt0_contents_seq1_seq) && session.slotsm_Farthest_alt0_contents_seq1_seq[slot] != 0 {
+		if memo := &session.memom_Farthest_alt0_contents_seq1_seq[session.slotsm_Farthest_alt0_contents_seq1_seq[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt0_contents_seq1_seq = append(session.memom_Farthest_alt0_contents_seq1_seq, struct {
+			result peg.Result
+			value  struct {
+				V0 string
+				V1 string
+				V2 string
+			}
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt0_contents_seq1_seq, here) = int32(len(session.memom_Farthest_alt0_contents_seq1_seq))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "a" "b" "c"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt0_contents_seq1_seq1_lit) && session.slotsm_Farthest_alt0_contents_seq1_seq1_lit[slot] != 0 {
+		if memo := &session.memom_Farthest_alt0_contents_seq1_seq1_lit[session.slotsm_Farthest_alt0_contents_seq1_seq1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt0_contents_seq1_seq1_lit = append(session.memom_Farthest_alt0_contents_seq1_seq1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt0_contents_seq1_seq1_lit, here) = int32(len(session.memom_Farthest_alt0_contents_seq1_seq1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "b"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "b" {
+		return session.failures.Fail(here, peg.Expected{Token: "b"}), ""
+	}
+	return peg.Success(here + 1), "b"
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt0_contents_seq1_seq2_lit) && session.slotsm_Farthest_alt0_contents_seq1_seq2_lit[slot] != 0 {
+		if memo := &session.memom_Farthest_alt0_contents_seq1_seq2_lit[session.slotsm_Farthest_alt0_contents_seq1_seq2_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq2_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt0_contents_seq1_seq2_lit = append(session.memom_Farthest_alt0_contents_seq1_seq2_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt0_contents_seq1_seq2_lit, here) = int32(len(session.memom_Farthest_alt0_contents_seq1_seq2_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "c"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "c" {
+		return session.failures.Fail(here, peg.Expected{Token: "c"}), ""
+	}
+	return peg.Success(here + 1), "c"
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq) && session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq[slot] != 0 {
+		if memo := &session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq[session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq = append(session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq, struct {
+			result peg.Result
+			value  struct {
+				V0 string
+				V1 string
+			}
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt2_contents_seq1_alt0_contents_seq, here) = int32(len(session.memom_Farthest_alt2_contents_seq1_alt0_contents_seq))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "a" "b"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit) && session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[slot] != 0 {
+		if memo := &session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = append(session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, here) = int32(len(session.memom_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "d"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "d" {
+		return session.failures.Fail(here, peg.Expected{Token: "d"}), ""
+	}
+	return peg.Success(here + 1), "d"
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Farthest_alt2_contents_seq1_alt2_lit) && session.slotsm_Farthest_alt2_contents_seq1_alt2_lit[slot] != 0 {
+		if memo := &session.memom_Farthest_alt2_contents_seq1_alt2_lit[session.slotsm_Farthest_alt2_contents_seq1_alt2_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt2_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Farthest_alt2_contents_seq1_alt2_lit = append(session.memom_Farthest_alt2_contents_seq1_alt2_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Farthest_alt2_contents_seq1_alt2_lit, here) = int32(len(session.memom_Farthest_alt2_contents_seq1_alt2_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "e"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "e" {
+		return session.failures.Fail(here, peg.Expected{Token: "e"}), ""
+	}
+	return peg.Success(here + 1), "e"
+}
+
+func (session *Session) m_Items(here int) (peg.Result, []string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Items) && session.slotsm_Items[slot] != 0 {
+		if memo := &session.memom_Items[session.slotsm_Items[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Items = append(session.memom_Items, struct {
+			result peg.Result
+			value  []string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Items, here) = int32(len(session.memom_Items))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Items
+func (session *Session) dm_Items(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		result := []string{}
+		var recovered []*peg.ParseError
+		for {
+			next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				check, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					check, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_name(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						if next, value := session.m_space(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					if !check.Ok {
+						var zero string
+						return check, zero
+					}
+					answer := func(arg struct {
+						V0 string
+						V1 string
+						V2 string
+					}) string {
+						return arg.V0
+					}(value)
+					return check, answer
+				}(here)
+				if check.Ok || session.failures.Silent != 0 {
+					return check, value
+				}
+				recovered := session.failure(check)
+				session.failures.Clear()
+				at := here
+				for session.available(here, at+1) {
+					session.failures.Silent++
+					sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+					session.failures.Silent--
+					if sync.Ok {
+						break
+					}
+					at = session.next(at)
+				}
+				var placeholder string = "?"
+				return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+			}(here)
+			if next.Fatal {
+				return next, nil
+			}
+			if !next.Ok {
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			if next.At == here {
+				session.failures.Discard(next.Recovered)
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			here = next.At
+			session.advance(mark, here)
+			recovered = append(recovered, next.Recovered...)
+			result = append(result, value)
+		}
+	}(here)
+}
+
+func (session *Session) m_Letters(here int) (peg.Result, []string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Letters) && session.slotsm_Letters[slot] != 0 {
+		if memo := &session.memom_Letters[session.slotsm_Letters[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Letters = append(session.memom_Letters, struct {
+			result peg.Result
+			value  []string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Letters, here) = int32(len(session.memom_Letters))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Letters
+func (session *Session) dm_Letters(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		result := []string{}
+		var recovered []*peg.ParseError
+		for {
+			next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = resourcem_Letters_star_alt0_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = resourcem_Letters_star_alt0_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "b"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here)
+			if next.Fatal {
+				return next, nil
+			}
+			if !next.Ok {
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			if next.At == here {
+				session.failures.Discard(next.Recovered)
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			here = next.At
+			session.advance(mark, here)
+			recovered = append(recovered, next.Recovered...)
+			result = append(result, value)
+		}
+	}(here)
+}
+
+func (session *Session) m_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Letters_star_alt1_lit) && session.slotsm_Letters_star_alt1_lit[slot] != 0 {
+		if memo := &session.memom_Letters_star_alt1_lit[session.slotsm_Letters_star_alt1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star_alt1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Letters_star_alt1_lit = append(session.memom_Letters_star_alt1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Letters_star_alt1_lit, here) = int32(len(session.memom_Letters_star_alt1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "a"
+func (session *Session) dm_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "a" {
+		return session.failures.Fail(here, peg.Expected{Token: "a"}), ""
+	}
+	return peg.Success(here + 1), "a"
+}
+
+func (session *Session) m_Nest(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Nest) && session.slotsm_Nest[slot] != 0 {
+		if memo := &session.memom_Nest[session.slotsm_Nest[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Nest = append(session.memom_Nest, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Nest, here) = int32(len(session.memom_Nest))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Nest
+func (session *Session) dm_Nest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 struct{}
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 struct{}
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, struct{}) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				session.failures.Silent++
+				check, _ := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := session.m_nest(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here)
+				session.failures.Silent--
+				if !check.Ok {
+					return peg.Success(here), struct{}{}
+				}
+				return session.failures.Fail(here, peg.Exclude{Message: "nest then \"!\""}), struct{}{}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 struct{}
+					V1 string
+				}{}
+			}
+			if next, value := session.m_nest(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 struct{}
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 struct{}
+			V1 string
+		}) string {
+			return arg.V1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_Number(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Number) && session.slotsm_Number[slot] != 0 {
+		if memo := &session.memom_Number[session.slotsm_Number[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Number(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Number = append(session.memom_Number, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Number, here) = int32(len(session.memom_Number))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Number
+func (session *Session) dm_Number(here int) (peg.Result, string) {
+	return session.m_number(here)
+}
+
+func (session *Session) m_Word(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Word) && session.slotsm_Word[slot] != 0 {
+		if memo := &session.memom_Word[session.slotsm_Word[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Word = append(session.memom_Word, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Word, here) = int32(len(session.memom_Word))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root Word
+func (session *Session) dm_Word(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 struct{}
+				V1 struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 struct{}
+					V1 struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, struct{}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					session.failures.Silent++
+					check, _ := func(here int) (peg.Result, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							if !session.available(here, here+2) || string(session.slice(here, here+2)) != "if" {
+								return session.failures.Fail(here, peg.Expected{Token: "if"}), ""
+							}
+							return peg.Success(here + 2), "if"
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							next.Fatal = true
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					session.failures.Silent--
+					if !check.Ok {
+						return peg.Success(here), struct{}{}
+					}
+					return session.failures.Fail(here, peg.Exclude{Message: "\"if\" then \"(\""}), struct{}{}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					check, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							next.Fatal = true
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					if !check.Ok {
+						check.Fatal = false
+						var zero struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						return check, zero
+					}
+					return peg.Success(here), value
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = resourcem_Word_alt0_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = resourcem_Word_alt0_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]+"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 struct{}
+				V1 struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}
+				V2 string
+			}) string {
+				return arg.V2
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Word_alt0_go_seq1_and_seq0_regex) && session.slotsm_Word_alt0_go_seq1_and_seq0_regex[slot] != 0 {
+		if memo := &session.memom_Word_alt0_go_seq1_and_seq0_regex[session.slotsm_Word_alt0_go_seq1_and_seq0_regex[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq1_and_seq0_regex(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Word_alt0_go_seq1_and_seq0_regex = append(session.memom_Word_alt0_go_seq1_and_seq0_regex, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Word_alt0_go_seq1_and_seq0_regex, here) = int32(len(session.memom_Word_alt0_go_seq1_and_seq0_regex))
+		session.count(here)
+	}
+	return result, value
+}
+
+// regex "[a-z]"
+func (session *Session) dm_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_Word_alt1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_Word_alt1_lit) && session.slotsm_Word_alt1_lit[slot] != 0 {
+		if memo := &session.memom_Word_alt1_lit[session.slotsm_Word_alt1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_Word_alt1_lit = append(session.memom_Word_alt1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_Word_alt1_lit, here) = int32(len(session.memom_Word_alt1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "x"
+func (session *Session) dm_Word_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "x" {
+		return session.failures.Fail(here, peg.Expected{Token: "x"}), ""
+	}
+	return peg.Success(here + 1), "x"
+}
+
+func (session *Session) m_keyword(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_keyword) && session.slotsm_keyword[slot] != 0 {
+		if memo := &session.memom_keyword[session.slotsm_keyword[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_keyword = append(session.memom_keyword, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_keyword, here) = int32(len(session.memom_keyword))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root keyword
+func (session *Session) dm_keyword(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 struct{}
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 struct{}
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 struct{}
+				}{}
+			}
+			if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 struct{}
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 struct{}
+		}) string {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_keyword_go_seq0_alt0_lit) && session.slotsm_keyword_go_seq0_alt0_lit[slot] != 0 {
+		if memo := &session.memom_keyword_go_seq0_alt0_lit[session.slotsm_keyword_go_seq0_alt0_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt0_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_keyword_go_seq0_alt0_lit = append(session.memom_keyword_go_seq0_alt0_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_keyword_go_seq0_alt0_lit, here) = int32(len(session.memom_keyword_go_seq0_alt0_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "let"
+func (session *Session) dm_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+3) || string(session.slice(here, here+3)) != "let" {
+		return session.failures.Fail(here, peg.Expected{Token: "let"}), ""
+	}
+	return peg.Success(here + 3), "let"
+}
+
+func (session *Session) m_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_keyword_go_seq0_alt1_lit) && session.slotsm_keyword_go_seq0_alt1_lit[slot] != 0 {
+		if memo := &session.memom_keyword_go_seq0_alt1_lit[session.slotsm_keyword_go_seq0_alt1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_keyword_go_seq0_alt1_lit = append(session.memom_keyword_go_seq0_alt1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_keyword_go_seq0_alt1_lit, here) = int32(len(session.memom_keyword_go_seq0_alt1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "print"
+func (session *Session) dm_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+5) || string(session.slice(here, here+5)) != "print" {
+		return session.failures.Fail(here, peg.Expected{Token: "print"}), ""
+	}
+	return peg.Success(here + 5), "print"
+}
+
+func (session *Session) m_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_keyword_go_seq1_not) && session.slotsm_keyword_go_seq1_not[slot] != 0 {
+		if memo := &session.memom_keyword_go_seq1_not[session.slotsm_keyword_go_seq1_not[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq1_not(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_keyword_go_seq1_not = append(session.memom_keyword_go_seq1_not, struct {
+			result peg.Result
+			value  struct{}
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_keyword_go_seq1_not, here) = int32(len(session.memom_keyword_go_seq1_not))
+		session.count(here)
+	}
+	return result, value
+}
+
+// not (regex "[a-z0-9]")
+func (session *Session) dm_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = resourcem_keyword_go_seq1_not_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = resourcem_keyword_go_seq1_not_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z0-9]"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "text matching `[a-z0-9]`"}), struct{}{}
+}
+
+func (session *Session) m_name(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_name) && session.slotsm_name[slot] != 0 {
+		if memo := &session.memom_name[session.slotsm_name[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_name = append(session.memom_name, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_name, here) = int32(len(session.memom_name))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root name
+func (session *Session) dm_name(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.failures.Mark()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct{}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					session.failures.Silent++
+					check, _ := session.m_keyword(here)
+					session.failures.Silent--
+					if !check.Ok {
+						return peg.Success(here), struct{}{}
+					}
+					return session.failures.Fail(here, peg.Exclude{Message: "keyword"}), struct{}{}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = resourcem_name_alias_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = resourcem_name_alias_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z][a-z0-9]*"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}) string {
+				return arg.V2
+			}(value)
+			return check, answer
+		}(here)
+		if !check.Ok {
+			return session.failures.Name(mark, session.named(here, check.At), check, "name"), value
+		}
+		return check, value
+	}(here)
+}
+
+func (session *Session) m_nest(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_nest) && session.slotsm_nest[slot] != 0 {
+		if memo := &session.memom_nest[session.slotsm_nest[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_nest = append(session.memom_nest, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_nest, here) = int32(len(session.memom_nest))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root nest
+func (session *Session) dm_nest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_nest(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_value_alt2_go_seq4_label_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 string
+			}) string {
+				return arg.V1
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_nest(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "]" {
+						return session.failures.Fail(here, peg.Expected{Token: "]"}), ""
+					}
+					return peg.Success(here + 1), "]"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 string
+			}) string {
+				return arg.V1
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_number(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_number) && session.slotsm_number[slot] != 0 {
+		if memo := &session.memom_number[session.slotsm_number[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_number = append(session.memom_number, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_number, here) = int32(len(session.memom_number))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root number
+func (session *Session) dm_number(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 float64
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 float64
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 float64
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, float64) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.failures.Mark()
+				check, value := func(here int) (peg.Result, float64) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.failures.Save()
+					check, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := func(here int) (peg.Result, struct {
+							V0 string
+							V1 []string
+							V2 *struct {
+								V0 string
+								V1 []string
+							}
+							V3 *string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 []string
+								V2 *struct {
+									V0 string
+									V1 []string
+								}
+								V3 *string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+								if !check.Ok {
+									check.Fatal = false
+									var zero string
+									return check, zero
+								}
+								return peg.Success(here), value
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := func(here int) (peg.Result, *struct {
+								V0 string
+								V1 []string
+							}) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := func(here int) (peg.Result, struct {
+									V0 string
+									V1 []string
+								}) {
+									session.enter(here)
+									defer session.leave()
+									result := struct {
+										V0 string
+										V1 []string
+									}{}
+									var recovered []*peg.ParseError
+									if next, value := func(here int) (peg.Result, string) {
+										session.enter(here)
+										defer session.leave()
+										if !session.available(here, here+1) || string(session.slice(here, here+1)) != "." {
+											return session.failures.Fail(here, peg.Expected{Token: "."}), ""
+										}
+										return peg.Success(here + 1), "."
+									}(here); next.Ok {
+										here = next.At
+										recovered = append(recovered, next.Recovered...)
+										result.V0 = value
+									} else {
+										return next, struct {
+											V0 string
+											V1 []string
+										}{}
+									}
+									if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+										here = next.At
+										recovered = append(recovered, next.Recovered...)
+										result.V1 = value
+									} else {
+										return next, struct {
+											V0 string
+											V1 []string
+										}{}
+									}
+									return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+								}(here)
+								if check.Ok {
+									return check, &value
+								}
+								if check.Fatal {
+									return check, nil
+								}
+								return peg.Success(here), nil
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := func(here int) (peg.Result, *string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := func(here int) (peg.Result, string) {
+									session.enter(here)
+									defer session.leave()
+									var match []int
+									if session.source == nil {
+										match = resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindIndex(session.slice(here, session.end()))
+									} else {
+										match = resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindReaderIndex(session.runes(here))
+									}
+									if match == nil {
+										return session.failures.Fail(here, peg.ExpectedPattern{Regex: "e[0-9]+"}), ""
+									}
+									end := match[1]
+									return peg.Success(here + end), string(session.slice(here, here+end))
+
+								}(here)
+								if check.Ok {
+									return check, &value
+								}
+								if check.Fatal {
+									return check, nil
+								}
+								return peg.Success(here), nil
+
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V3 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here)
+					if !check.Ok {
+						var zero float64
+						return check, zero
+					}
+					answer, err := func(arg string) (float64, error) {
+						return strconv.ParseFloat(arg, 64)
+					}(value)
+					if err != nil {
+						return session.failures.Reject(mark, here, check.At, peg.Invalid{Err: err}), answer
+					}
+					return check, answer
+				}(here)
+				if !check.Ok {
+					return session.failures.Name(mark, session.named(here, check.At), check, "number"), value
+				}
+				return check, value
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 float64
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 float64
+		}) string {
+			return strconv.FormatFloat(arg.V1, 'g', -1, 64)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex) && session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex[slot] != 0 {
+		if memo := &session.memom_number_go_seq1_alias_try_contents_seq0_and_regex[session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_number_go_seq1_alias_try_contents_seq0_and_regex = append(session.memom_number_go_seq1_alias_try_contents_seq0_and_regex, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_number_go_seq1_alias_try_contents_seq0_and_regex, here) = int32(len(session.memom_number_go_seq1_alias_try_contents_seq0_and_regex))
+		session.count(here)
+	}
+	return result, value
+}
+
+// regex "[0-9]"
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[0-9]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_number_go_seq1_alias_try_contents_seq1_plus) && session.slotsm_number_go_seq1_alias_try_contents_seq1_plus[slot] != 0 {
+		if memo := &session.memom_number_go_seq1_alias_try_contents_seq1_plus[session.slotsm_number_go_seq1_alias_try_contents_seq1_plus[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq1_plus(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_number_go_seq1_alias_try_contents_seq1_plus = append(session.memom_number_go_seq1_alias_try_contents_seq1_plus, struct {
+			result peg.Result
+			value  []string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_number_go_seq1_alias_try_contents_seq1_plus, here) = int32(len(session.memom_number_go_seq1_alias_try_contents_seq1_plus))
+		session.count(here)
+	}
+	return result, value
+}
+
+// (regex "[0-9]")+
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+		if !next.Ok {
+			if len(result) == 0 || next.Fatal {
+				return next, nil
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here && len(result) != 0 {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_space(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_space) && session.slotsm_space[slot] != 0 {
+		if memo := &session.memom_space[session.slotsm_space[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_space(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_space = append(session.memom_space, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_space, here) = int32(len(session.memom_space))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root space
+func (session *Session) dm_space(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = resourcem_space_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = resourcem_space_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[ \\t\\r\\n]*"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
+}
+
+func (session *Session) m_statement(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_statement) && session.slotsm_statement[slot] != 0 {
+		if memo := &session.memom_statement[session.slotsm_statement[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_statement = append(session.memom_statement, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_statement, here) = int32(len(session.memom_statement))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root statement
+func (session *Session) dm_statement(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 string
+				V5 string
+				V6 string
+				V7 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				start := here
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 struct{}
+					V4 string
+					V5 string
+					V6 string
+					V7 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_name(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V4 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V5 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					check, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+1) || string(session.slice(here, here+1)) != "=" {
+							return session.failures.Fail(here, peg.Expected{Token: "="}), ""
+						}
+						return peg.Success(here + 1), "="
+					}(here)
+					if !check.Ok {
+						return session.failures.Label(here, "missing equals sign", false), value
+					}
+					return check, value
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V6 = value
+				} else {
+					next.Fatal = true
+					next = session.failures.Open(next, start)
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_value(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V7 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 string
+				V5 string
+				V6 string
+				V7 string
+			}) string {
+				return arg.V4 + "=" + arg.V7
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 []string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 struct{}
+					V4 []string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, []string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					result := []string{}
+					var recovered []*peg.ParseError
+					for {
+						next, value := session.m_value(here)
+						if !next.Ok {
+							if len(result) == 0 || next.Fatal {
+								return next, nil
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}
+						if next.At == here && len(result) != 0 {
+							session.failures.Discard(next.Recovered)
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}
+						here = next.At
+						session.advance(mark, here)
+						recovered = append(recovered, next.Recovered...)
+						result = append(result, value)
+					}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V4 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 []string
+			}) string {
+				return "print " + strings.Join(arg.V4, " ")
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_statement_alt0_go_seq3_cut) && session.slotsm_statement_alt0_go_seq3_cut[slot] != 0 {
+		if memo := &session.memom_statement_alt0_go_seq3_cut[session.slotsm_statement_alt0_go_seq3_cut[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq3_cut(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_statement_alt0_go_seq3_cut = append(session.memom_statement_alt0_go_seq3_cut, struct {
+			result peg.Result
+			value  struct{}
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_statement_alt0_go_seq3_cut, here) = int32(len(session.memom_statement_alt0_go_seq3_cut))
+		session.count(here)
+	}
+	return result, value
+}
+
+// ~
+func (session *Session) dm_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	return peg.Success(here), struct{}{}
+}
+
+func (session *Session) m_value(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_value) && session.slotsm_value[slot] != 0 {
+		if memo := &session.memom_value[session.slotsm_value[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_value = append(session.memom_value, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_value, here) = int32(len(session.memom_value))
+		session.count(here)
+	}
+	return result, value
+}
+
+// root value
+func (session *Session) dm_value(here int) (peg.Result, string) {
+	return session.m_value_alt(here)
+}
+
+func (session *Session) m_value_alt(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_value_alt) && session.slotsm_value_alt[slot] != 0 {
+		if memo := &session.memom_value_alt[session.slotsm_value_alt[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_value_alt = append(session.memom_value_alt, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_value_alt, here) = int32(len(session.memom_value_alt))
+		session.count(here)
+	}
+	return result, value
+}
+
+// (root number / root name / root space "(" root value root space ")"^^"unclosed parenthesis" go string { "(" + arg.V2 + ")" } / root space "!" try! string { "", errors.New("values can't be shouted") })
+func (session *Session) dm_value_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_number(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_name(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			start := here
+			mark := session.hold(here)
+			defer session.release(mark)
+			result := struct {
+				V0 string
+				V1 string
+				V2 string
+				V3 string
+				V4 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_value(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V3 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				check, value := session.m_value_alt2_go_seq4_label_lit(here)
+				if !check.Ok {
+					return session.failures.Label(here, "unclosed parenthesis", true), value
+				}
+				return check, value
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V4 = value
+			} else {
+				next = session.failures.Open(next, start)
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}) string {
+			return "(" + arg.V2 + ")"
+		}(value)
+		return check, answer
+	}(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer, err := func(arg struct {
+			V0 string
+			V1 string
+		}) (string, error) {
+			return "", errors.New("values can't be shouted")
+		}(value)
+		if err != nil {
+			return session.failures.Abort(here, peg.Invalid{Err: err}), answer
+		}
+		return check, answer
+	}(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_value_alt2_go_seq1_lit) && session.slotsm_value_alt2_go_seq1_lit[slot] != 0 {
+		if memo := &session.memom_value_alt2_go_seq1_lit[session.slotsm_value_alt2_go_seq1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_value_alt2_go_seq1_lit = append(session.memom_value_alt2_go_seq1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_value_alt2_go_seq1_lit, here) = int32(len(session.memom_value_alt2_go_seq1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "("
+func (session *Session) dm_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "(" {
+		return session.failures.Fail(here, peg.Expected{Token: "("}), ""
+	}
+	return peg.Success(here + 1), "("
+}
+
+func (session *Session) m_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_value_alt2_go_seq4_label_lit) && session.slotsm_value_alt2_go_seq4_label_lit[slot] != 0 {
+		if memo := &session.memom_value_alt2_go_seq4_label_lit[session.slotsm_value_alt2_go_seq4_label_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq4_label_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_value_alt2_go_seq4_label_lit = append(session.memom_value_alt2_go_seq4_label_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_value_alt2_go_seq4_label_lit, here) = int32(len(session.memom_value_alt2_go_seq4_label_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// ")"
+func (session *Session) dm_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ")" {
+		return session.failures.Fail(here, peg.Expected{Token: ")"}), ""
+	}
+	return peg.Success(here + 1), ")"
+}
+
+func (session *Session) m_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slotsm_value_alt3_try_seq1_lit) && session.slotsm_value_alt3_try_seq1_lit[slot] != 0 {
+		if memo := &session.memom_value_alt3_try_seq1_lit[session.slotsm_value_alt3_try_seq1_lit[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt3_try_seq1_lit(here)
+	session.depth--
+	if here >= session.window {
+		session.memom_value_alt3_try_seq1_lit = append(session.memom_value_alt3_try_seq1_lit, struct {
+			result peg.Result
+			value  string
+			silent bool
+		}{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slotsm_value_alt3_try_seq1_lit, here) = int32(len(session.memom_value_alt3_try_seq1_lit))
+		session.count(here)
+	}
+	return result, value
+}
+
+// "!"
+func (session *Session) dm_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "!" {
+		return session.failures.Fail(here, peg.Expected{Token: "!"}), ""
+	}
+	return peg.Success(here + 1), "!"
+}
@@ -0,0 +1,9875 @@
+package every
+
+import "context"
+import "errors"
+import "io"
+import "regexp"
+import "strconv"
+import "strings"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
+
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
+
+func NewParser() Parser {
+	return Parser{}
+}
+
+// ParseDoc parses the whole input as Doc, in a fresh session.
+func (parser Parser) ParseDoc(input []byte) ([]string, error) {
+	return parser.NewSession(input).Doc()
+}
+
+// ParseDocPrefix parses as much of the input as Doc matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseDocPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).DocPrefix()
+}
+
+// ParseDocContext parses the input as Doc, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseDocContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Doc()
+}
+
+// ParseDocReader parses the input read from the source as Doc, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseDocReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Doc()
+}
+
+// ParseFarthest parses the whole input as Farthest, in a fresh session.
+func (parser Parser) ParseFarthest(input []byte) (string, error) {
+	return parser.NewSession(input).Farthest()
+}
+
+// ParseFarthestPrefix parses as much of the input as Farthest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseFarthestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).FarthestPrefix()
+}
+
+// ParseFarthestContext parses the input as Farthest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseFarthestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Farthest()
+}
+
+// ParseFarthestReader parses the input read from the source as Farthest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseFarthestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Farthest()
+}
+
+// ParseItems parses the whole input as Items, in a fresh session.
+func (parser Parser) ParseItems(input []byte) ([]string, error) {
+	return parser.NewSession(input).Items()
+}
+
+// ParseItemsPrefix parses as much of the input as Items matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseItemsPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).ItemsPrefix()
+}
+
+// ParseItemsContext parses the input as Items, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseItemsContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Items()
+}
+
+// ParseItemsReader parses the input read from the source as Items, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseItemsReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Items()
+}
+
+// ParseLetters parses the whole input as Letters, in a fresh session.
+func (parser Parser) ParseLetters(input []byte) ([]string, error) {
+	return parser.NewSession(input).Letters()
+}
+
+// ParseLettersPrefix parses as much of the input as Letters matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseLettersPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).LettersPrefix()
+}
+
+// ParseLettersContext parses the input as Letters, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseLettersContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Letters()
+}
+
+// ParseLettersReader parses the input read from the source as Letters, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseLettersReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Letters()
+}
+
+// ParseNest parses the whole input as Nest, in a fresh session.
+func (parser Parser) ParseNest(input []byte) (string, error) {
+	return parser.NewSession(input).Nest()
+}
+
+// ParseNestPrefix parses as much of the input as Nest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NestPrefix()
+}
+
+// ParseNestContext parses the input as Nest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Nest()
+}
+
+// ParseNestReader parses the input read from the source as Nest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Nest()
+}
+
+// ParseNumber parses the whole input as Number, in a fresh session.
+func (parser Parser) ParseNumber(input []byte) (string, error) {
+	return parser.NewSession(input).Number()
+}
+
+// ParseNumberPrefix parses as much of the input as Number matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNumberPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NumberPrefix()
+}
+
+// ParseNumberContext parses the input as Number, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNumberContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Number()
+}
+
+// ParseNumberReader parses the input read from the source as Number, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNumberReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Number()
+}
+
+// ParseWord parses the whole input as Word, in a fresh session.
+func (parser Parser) ParseWord(input []byte) (string, error) {
+	return parser.NewSession(input).Word()
+}
+
+// ParseWordPrefix parses as much of the input as Word matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseWordPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).WordPrefix()
+}
+
+// ParseWordContext parses the input as Word, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseWordContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Word()
+}
+
+// ParseWordReader parses the input read from the source as Word, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseWordReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Word()
+}
+
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
+	// Internal memoization tables
+	wherem_Doc_go     map[int]peg.Result
+	whatm_Doc_go      map[int][]string
+	wherem_Doc_go_seq map[int]peg.Result
+	whatm_Doc_go_seq  map[int]struct {
+		V0 []string
+		V1 string
+	}
+	wherem_Doc_go_seq0_star        map[int]peg.Result
+	whatm_Doc_go_seq0_star         map[int][]string
+	wherem_Doc_go_seq0_star_go     map[int]peg.Result
+	whatm_Doc_go_seq0_star_go      map[int]string
+	wherem_Doc_go_seq0_star_go_seq map[int]peg.Result
+	whatm_Doc_go_seq0_star_go_seq  map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_Doc_go_seq0_star_go_seq0_recover      map[int]peg.Result
+	whatm_Doc_go_seq0_star_go_seq0_recover       map[int]string
+	wherem_Doc_go_seq0_star_go_seq0_recover1_lit map[int]peg.Result
+	whatm_Doc_go_seq0_star_go_seq0_recover1_lit  map[int]string
+	wherem_Farthest_alt                          map[int]peg.Result
+	whatm_Farthest_alt                           map[int]string
+	wherem_Farthest_alt0_contents                map[int]peg.Result
+	whatm_Farthest_alt0_contents                 map[int]string
+	wherem_Farthest_alt0_contents_seq            map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq             map[int]struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+	}
+	wherem_Farthest_alt0_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt0_contents_seq1_seq map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq  map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_Farthest_alt0_contents_seq1_seq1_lit map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq1_lit  map[int]string
+	wherem_Farthest_alt0_contents_seq1_seq2_lit map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq2_lit  map[int]string
+	wherem_Farthest_alt1_contents               map[int]peg.Result
+	whatm_Farthest_alt1_contents                map[int]string
+	wherem_Farthest_alt1_contents_seq           map[int]peg.Result
+	whatm_Farthest_alt1_contents_seq            map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt1_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt1_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt1_contents_seq1_alt map[int]peg.Result
+	whatm_Farthest_alt1_contents_seq1_alt  map[int]string
+	wherem_Farthest_alt2_contents          map[int]peg.Result
+	whatm_Farthest_alt2_contents           map[int]string
+	wherem_Farthest_alt2_contents_seq      map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq       map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt2_contents_seq0_lit               map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq0_lit                map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt               map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt                map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt0_contents     map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt0_contents      map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt0_contents_seq map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt0_contents_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt2_contents_seq1_alt1_contents     map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt1_contents      map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt1_contents_seq map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt1_contents_seq  map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit  map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt2_lit               map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt2_lit                map[int]string
+	wherem_Farthest_alt3_contents                             map[int]peg.Result
+	whatm_Farthest_alt3_contents                              map[int]string
+	wherem_Farthest_alt3_contents_seq                         map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq                          map[int]struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}
+	wherem_Farthest_alt3_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt3_contents_seq1_seq map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq1_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt3_contents_seq1_seq0_alt               map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq1_seq0_alt                map[int]string
+	wherem_Farthest_alt3_contents_seq1_seq1_alt               map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq1_seq1_alt                map[int]string
+	wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents     map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents      map[int]string
+	wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq map[int]peg.Result
+	whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt4_contents     map[int]peg.Result
+	whatm_Farthest_alt4_contents      map[int]string
+	wherem_Farthest_alt4_contents_seq map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq  map[int]struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}
+	wherem_Farthest_alt4_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt4_contents_seq1_alt map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq1_alt  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt4_contents_seq1_alt0_seq map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq1_alt0_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt  map[int]string
+	wherem_Farthest_alt4_contents_seq1_alt1_seq      map[int]peg.Result
+	whatm_Farthest_alt4_contents_seq1_alt1_seq       map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt5_contents     map[int]peg.Result
+	whatm_Farthest_alt5_contents      map[int]string
+	wherem_Farthest_alt5_contents_seq map[int]peg.Result
+	whatm_Farthest_alt5_contents_seq  map[int]struct {
+		V0 string
+		V1 struct {
+			V0 []string
+			V1 string
+		}
+	}
+	wherem_Farthest_alt5_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt5_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt5_contents_seq1_seq map[int]peg.Result
+	whatm_Farthest_alt5_contents_seq1_seq  map[int]struct {
+		V0 []string
+		V1 string
+	}
+	wherem_Farthest_alt5_contents_seq1_seq0_star map[int]peg.Result
+	whatm_Farthest_alt5_contents_seq1_seq0_star  map[int][]string
+	wherem_Farthest_alt6_contents                map[int]peg.Result
+	whatm_Farthest_alt6_contents                 map[int]string
+	wherem_Farthest_alt6_contents_seq            map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq             map[int]struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}
+	wherem_Farthest_alt6_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt6_contents_seq1_seq map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq1_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt6_contents_seq1_seq0_alt           map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq1_seq0_alt            map[int]string
+	wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents  map[int]string
+	wherem_Farthest_alt6_contents_seq1_seq1_lit           map[int]peg.Result
+	whatm_Farthest_alt6_contents_seq1_seq1_lit            map[int]string
+	wherem_Farthest_alt7_contents                         map[int]peg.Result
+	whatm_Farthest_alt7_contents                          map[int]string
+	wherem_Farthest_alt7_contents_seq                     map[int]peg.Result
+	whatm_Farthest_alt7_contents_seq                      map[int]struct {
+		V0 string
+		V1 struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}
+	}
+	wherem_Farthest_alt7_contents_seq0_lit map[int]peg.Result
+	whatm_Farthest_alt7_contents_seq0_lit  map[int]string
+	wherem_Farthest_alt7_contents_seq1_seq map[int]peg.Result
+	whatm_Farthest_alt7_contents_seq1_seq  map[int]struct {
+		V0 struct{}
+		V1 string
+		V2 string
+	}
+	wherem_Farthest_alt7_contents_seq1_seq0_not map[int]peg.Result
+	whatm_Farthest_alt7_contents_seq1_seq0_not  map[int]struct{}
+	wherem_Farthest_alt8_contents               map[int]peg.Result
+	whatm_Farthest_alt8_contents                map[int]string
+	wherem_Farthest_alt8_contents_seq           map[int]peg.Result
+	whatm_Farthest_alt8_contents_seq            map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt8_contents_seq0_lit      map[int]peg.Result
+	whatm_Farthest_alt8_contents_seq0_lit       map[int]string
+	wherem_Farthest_alt8_contents_seq1_alt      map[int]peg.Result
+	whatm_Farthest_alt8_contents_seq1_alt       map[int]string
+	wherem_Farthest_alt8_contents_seq1_alt1_lit map[int]peg.Result
+	whatm_Farthest_alt8_contents_seq1_alt1_lit  map[int]string
+	wherem_Items_star                           map[int]peg.Result
+	whatm_Items_star                            map[int][]string
+	wherem_Items_star_recover                   map[int]peg.Result
+	whatm_Items_star_recover                    map[int]string
+	wherem_Items_star_recover0_go               map[int]peg.Result
+	whatm_Items_star_recover0_go                map[int]string
+	wherem_Items_star_recover0_go_seq           map[int]peg.Result
+	whatm_Items_star_recover0_go_seq            map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_Letters_star            map[int]peg.Result
+	whatm_Letters_star             map[int][]string
+	wherem_Letters_star_alt        map[int]peg.Result
+	whatm_Letters_star_alt         map[int]string
+	wherem_Letters_star_alt0_regex map[int]peg.Result
+	whatm_Letters_star_alt0_regex  map[int]string
+	wherem_Letters_star_alt1_lit   map[int]peg.Result
+	whatm_Letters_star_alt1_lit    map[int]string
+	wherem_Nest_go                 map[int]peg.Result
+	whatm_Nest_go                  map[int]string
+	wherem_Nest_go_seq             map[int]peg.Result
+	whatm_Nest_go_seq              map[int]struct {
+		V0 struct{}
+		V1 string
+	}
+	wherem_Nest_go_seq0_not     map[int]peg.Result
+	whatm_Nest_go_seq0_not      map[int]struct{}
+	wherem_Nest_go_seq0_not_seq map[int]peg.Result
+	whatm_Nest_go_seq0_not_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Word_alt         map[int]peg.Result
+	whatm_Word_alt          map[int]string
+	wherem_Word_alt0_go     map[int]peg.Result
+	whatm_Word_alt0_go      map[int]string
+	wherem_Word_alt0_go_seq map[int]peg.Result
+	whatm_Word_alt0_go_seq  map[int]struct {
+		V0 struct{}
+		V1 struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		V2 string
+	}
+	wherem_Word_alt0_go_seq0_not     map[int]peg.Result
+	whatm_Word_alt0_go_seq0_not      map[int]struct{}
+	wherem_Word_alt0_go_seq0_not_seq map[int]peg.Result
+	whatm_Word_alt0_go_seq0_not_seq  map[int]struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	wherem_Word_alt0_go_seq0_not_seq0_lit map[int]peg.Result
+	whatm_Word_alt0_go_seq0_not_seq0_lit  map[int]string
+	wherem_Word_alt0_go_seq1_and          map[int]peg.Result
+	whatm_Word_alt0_go_seq1_and           map[int]struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	wherem_Word_alt0_go_seq1_and_seq map[int]peg.Result
+	whatm_Word_alt0_go_seq1_and_seq  map[int]struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	wherem_Word_alt0_go_seq1_and_seq0_regex map[int]peg.Result
+	whatm_Word_alt0_go_seq1_and_seq0_regex  map[int]string
+	wherem_Word_alt0_go_seq2_regex          map[int]peg.Result
+	whatm_Word_alt0_go_seq2_regex           map[int]string
+	wherem_Word_alt1_lit                    map[int]peg.Result
+	whatm_Word_alt1_lit                     map[int]string
+	wherem_keyword_go_seq                   map[int]peg.Result
+	whatm_keyword_go_seq                    map[int]struct {
+		V0 string
+		V1 struct{}
+	}
+	wherem_keyword_go_seq0_alt       map[int]peg.Result
+	whatm_keyword_go_seq0_alt        map[int]string
+	wherem_keyword_go_seq0_alt0_lit  map[int]peg.Result
+	whatm_keyword_go_seq0_alt0_lit   map[int]string
+	wherem_keyword_go_seq0_alt1_lit  map[int]peg.Result
+	whatm_keyword_go_seq0_alt1_lit   map[int]string
+	wherem_keyword_go_seq1_not       map[int]peg.Result
+	whatm_keyword_go_seq1_not        map[int]struct{}
+	wherem_keyword_go_seq1_not_regex map[int]peg.Result
+	whatm_keyword_go_seq1_not_regex  map[int]string
+	wherem_name_alias                map[int]peg.Result
+	whatm_name_alias                 map[int]string
+	wherem_name_alias_go             map[int]peg.Result
+	whatm_name_alias_go              map[int]string
+	wherem_name_alias_go_seq         map[int]peg.Result
+	whatm_name_alias_go_seq          map[int]struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	wherem_name_alias_go_seq1_not   map[int]peg.Result
+	whatm_name_alias_go_seq1_not    map[int]struct{}
+	wherem_name_alias_go_seq2_regex map[int]peg.Result
+	whatm_name_alias_go_seq2_regex  map[int]string
+	wherem_nest_alt                 map[int]peg.Result
+	whatm_nest_alt                  map[int]string
+	wherem_nest_alt0_go             map[int]peg.Result
+	whatm_nest_alt0_go              map[int]string
+	wherem_nest_alt0_go_seq         map[int]peg.Result
+	whatm_nest_alt0_go_seq          map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_nest_alt1_go     map[int]peg.Result
+	whatm_nest_alt1_go      map[int]string
+	wherem_nest_alt1_go_seq map[int]peg.Result
+	whatm_nest_alt1_go_seq  map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_nest_alt1_go_seq2_lit map[int]peg.Result
+	whatm_nest_alt1_go_seq2_lit  map[int]string
+	wherem_number_go             map[int]peg.Result
+	whatm_number_go              map[int]string
+	wherem_number_go_seq         map[int]peg.Result
+	whatm_number_go_seq          map[int]struct {
+		V0 string
+		V1 float64
+	}
+	wherem_number_go_seq1_alias                  map[int]peg.Result
+	whatm_number_go_seq1_alias                   map[int]float64
+	wherem_number_go_seq1_alias_try              map[int]peg.Result
+	whatm_number_go_seq1_alias_try               map[int]float64
+	wherem_number_go_seq1_alias_try_contents     map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents      map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq  map[int]struct {
+		V0 string
+		V1 []string
+		V2 *struct {
+			V0 string
+			V1 []string
+		}
+		V3 *string
+	}
+	wherem_number_go_seq1_alias_try_contents_seq0_and       map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq0_and        map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq0_and_regex map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq0_and_regex  map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq1_plus      map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq1_plus       map[int][]string
+	wherem_number_go_seq1_alias_try_contents_seq2_opt       map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq2_opt        map[int]*struct {
+		V0 string
+		V1 []string
+	}
+	wherem_number_go_seq1_alias_try_contents_seq2_opt_seq map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq2_opt_seq  map[int]struct {
+		V0 string
+		V1 []string
+	}
+	wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit  map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq3_opt          map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq3_opt           map[int]*string
+	wherem_number_go_seq1_alias_try_contents_seq3_opt_regex    map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq3_opt_regex     map[int]string
+	wherem_space_regex                                         map[int]peg.Result
+	whatm_space_regex                                          map[int]string
+	wherem_statement_alt                                       map[int]peg.Result
+	whatm_statement_alt                                        map[int]string
+	wherem_statement_alt0_go                                   map[int]peg.Result
+	whatm_statement_alt0_go                                    map[int]string
+	wherem_statement_alt0_go_seq                               map[int]peg.Result
+	whatm_statement_alt0_go_seq                                map[int]struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 string
+		V5 string
+		V6 string
+		V7 string
+	}
+	wherem_statement_alt0_go_seq3_cut       map[int]peg.Result
+	whatm_statement_alt0_go_seq3_cut        map[int]struct{}
+	wherem_statement_alt0_go_seq6_label     map[int]peg.Result
+	whatm_statement_alt0_go_seq6_label      map[int]string
+	wherem_statement_alt0_go_seq6_label_lit map[int]peg.Result
+	whatm_statement_alt0_go_seq6_label_lit  map[int]string
+	wherem_statement_alt1_go                map[int]peg.Result
+	whatm_statement_alt1_go                 map[int]string
+	wherem_statement_alt1_go_seq            map[int]peg.Result
+	whatm_statement_alt1_go_seq             map[int]struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 []string
+	}
+	wherem_statement_alt1_go_seq4_plus map[int]peg.Result
+	whatm_statement_alt1_go_seq4_plus  map[int][]string
+	wherem_value_alt                   map[int]peg.Result
+	whatm_value_alt                    map[int]string
+	wherem_value_alt2_go               map[int]peg.Result
+	whatm_value_alt2_go                map[int]string
+	wherem_value_alt2_go_seq           map[int]peg.Result
+	whatm_value_alt2_go_seq            map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+		V3 string
+		V4 string
+	}
+	wherem_value_alt2_go_seq1_lit       map[int]peg.Result
+	whatm_value_alt2_go_seq1_lit        map[int]string
+	wherem_value_alt2_go_seq4_label     map[int]peg.Result
+	whatm_value_alt2_go_seq4_label      map[int]string
+	wherem_value_alt2_go_seq4_label_lit map[int]peg.Result
+	whatm_value_alt2_go_seq4_label_lit  map[int]string
+	wherem_value_alt3_try               map[int]peg.Result
+	whatm_value_alt3_try                map[int]string
+	wherem_value_alt3_try_seq           map[int]peg.Result
+	whatm_value_alt3_try_seq            map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_value_alt3_try_seq1_lit map[int]peg.Result
+	whatm_value_alt3_try_seq1_lit  map[int]string
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.wherem_Doc_go == nil {
+		session.wherem_Doc_go = map[int]peg.Result{}
+		session.whatm_Doc_go = map[int][]string{}
+	}
+	for key := range session.wherem_Doc_go {
+		delete(session.wherem_Doc_go, key)
+		delete(session.whatm_Doc_go, key)
+	}
+	if session.wherem_Doc_go_seq == nil {
+		session.wherem_Doc_go_seq = map[int]peg.Result{}
+		session.whatm_Doc_go_seq = map[int]struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Doc_go_seq {
+		delete(session.wherem_Doc_go_seq, key)
+		delete(session.whatm_Doc_go_seq, key)
+	}
+	if session.wherem_Doc_go_seq0_star == nil {
+		session.wherem_Doc_go_seq0_star = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star = map[int][]string{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star {
+		delete(session.wherem_Doc_go_seq0_star, key)
+		delete(session.whatm_Doc_go_seq0_star, key)
+	}
+	if session.wherem_Doc_go_seq0_star_go == nil {
+		session.wherem_Doc_go_seq0_star_go = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star_go = map[int]string{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star_go {
+		delete(session.wherem_Doc_go_seq0_star_go, key)
+		delete(session.whatm_Doc_go_seq0_star_go, key)
+	}
+	if session.wherem_Doc_go_seq0_star_go_seq == nil {
+		session.wherem_Doc_go_seq0_star_go_seq = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star_go_seq {
+		delete(session.wherem_Doc_go_seq0_star_go_seq, key)
+		delete(session.whatm_Doc_go_seq0_star_go_seq, key)
+	}
+	if session.wherem_Doc_go_seq0_star_go_seq0_recover == nil {
+		session.wherem_Doc_go_seq0_star_go_seq0_recover = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star_go_seq0_recover = map[int]string{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover {
+		delete(session.wherem_Doc_go_seq0_star_go_seq0_recover, key)
+		delete(session.whatm_Doc_go_seq0_star_go_seq0_recover, key)
+	}
+	if session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit == nil {
+		session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit {
+		delete(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+		delete(session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+	}
+	if session.wherem_Farthest_alt == nil {
+		session.wherem_Farthest_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt {
+		delete(session.wherem_Farthest_alt, key)
+		delete(session.whatm_Farthest_alt, key)
+	}
+	if session.wherem_Farthest_alt0_contents == nil {
+		session.wherem_Farthest_alt0_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents {
+		delete(session.wherem_Farthest_alt0_contents, key)
+		delete(session.whatm_Farthest_alt0_contents, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq == nil {
+		session.wherem_Farthest_alt0_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq {
+		delete(session.wherem_Farthest_alt0_contents_seq, key)
+		delete(session.whatm_Farthest_alt0_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt0_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt0_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt0_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq1_lit == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq1_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq1_lit {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq1_lit, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq1_lit, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq2_lit == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq2_lit {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq2_lit, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq2_lit, key)
+	}
+	if session.wherem_Farthest_alt1_contents == nil {
+		session.wherem_Farthest_alt1_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt1_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt1_contents {
+		delete(session.wherem_Farthest_alt1_contents, key)
+		delete(session.whatm_Farthest_alt1_contents, key)
+	}
+	if session.wherem_Farthest_alt1_contents_seq == nil {
+		session.wherem_Farthest_alt1_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt1_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt1_contents_seq {
+		delete(session.wherem_Farthest_alt1_contents_seq, key)
+		delete(session.whatm_Farthest_alt1_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt1_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt1_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt1_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt1_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt1_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt1_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt1_contents_seq1_alt == nil {
+		session.wherem_Farthest_alt1_contents_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt1_contents_seq1_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt1_contents_seq1_alt {
+		delete(session.wherem_Farthest_alt1_contents_seq1_alt, key)
+		delete(session.whatm_Farthest_alt1_contents_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt2_contents == nil {
+		session.wherem_Farthest_alt2_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents {
+		delete(session.wherem_Farthest_alt2_contents, key)
+		delete(session.whatm_Farthest_alt2_contents, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq == nil {
+		session.wherem_Farthest_alt2_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq {
+		delete(session.wherem_Farthest_alt2_contents_seq, key)
+		delete(session.whatm_Farthest_alt2_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt2_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt2_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt2_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt0_contents == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt0_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt0_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt1_contents == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt1_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt1_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt2_lit == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt2_lit {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt2_lit, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt2_lit, key)
+	}
+	if session.wherem_Farthest_alt3_contents == nil {
+		session.wherem_Farthest_alt3_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents {
+		delete(session.wherem_Farthest_alt3_contents, key)
+		delete(session.whatm_Farthest_alt3_contents, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq == nil {
+		session.wherem_Farthest_alt3_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq {
+		delete(session.wherem_Farthest_alt3_contents_seq, key)
+		delete(session.whatm_Farthest_alt3_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt3_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt3_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt3_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt3_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq1_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt3_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt3_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq1_seq0_alt == nil {
+		session.wherem_Farthest_alt3_contents_seq1_seq0_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq1_seq0_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq0_alt {
+		delete(session.wherem_Farthest_alt3_contents_seq1_seq0_alt, key)
+		delete(session.whatm_Farthest_alt3_contents_seq1_seq0_alt, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq1_seq1_alt == nil {
+		session.wherem_Farthest_alt3_contents_seq1_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq1_seq1_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt {
+		delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt, key)
+		delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents == nil {
+		session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents {
+		delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents, key)
+		delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents, key)
+	}
+	if session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq == nil {
+		session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq {
+		delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq, key)
+		delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt4_contents == nil {
+		session.wherem_Farthest_alt4_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents {
+		delete(session.wherem_Farthest_alt4_contents, key)
+		delete(session.whatm_Farthest_alt4_contents, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq == nil {
+		session.wherem_Farthest_alt4_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq {
+		delete(session.wherem_Farthest_alt4_contents_seq, key)
+		delete(session.whatm_Farthest_alt4_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt4_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt4_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt4_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq1_alt == nil {
+		session.wherem_Farthest_alt4_contents_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq1_alt = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt {
+		delete(session.wherem_Farthest_alt4_contents_seq1_alt, key)
+		delete(session.whatm_Farthest_alt4_contents_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq1_alt0_seq == nil {
+		session.wherem_Farthest_alt4_contents_seq1_alt0_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq1_alt0_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt0_seq {
+		delete(session.wherem_Farthest_alt4_contents_seq1_alt0_seq, key)
+		delete(session.whatm_Farthest_alt4_contents_seq1_alt0_seq, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt == nil {
+		session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt {
+		delete(session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt, key)
+		delete(session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt4_contents_seq1_alt1_seq == nil {
+		session.wherem_Farthest_alt4_contents_seq1_alt1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt4_contents_seq1_alt1_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt1_seq {
+		delete(session.wherem_Farthest_alt4_contents_seq1_alt1_seq, key)
+		delete(session.whatm_Farthest_alt4_contents_seq1_alt1_seq, key)
+	}
+	if session.wherem_Farthest_alt5_contents == nil {
+		session.wherem_Farthest_alt5_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt5_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt5_contents {
+		delete(session.wherem_Farthest_alt5_contents, key)
+		delete(session.whatm_Farthest_alt5_contents, key)
+	}
+	if session.wherem_Farthest_alt5_contents_seq == nil {
+		session.wherem_Farthest_alt5_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt5_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 []string
+				V1 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt5_contents_seq {
+		delete(session.wherem_Farthest_alt5_contents_seq, key)
+		delete(session.whatm_Farthest_alt5_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt5_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt5_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt5_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt5_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt5_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt5_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt5_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt5_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt5_contents_seq1_seq = map[int]struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt5_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt5_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt5_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt5_contents_seq1_seq0_star == nil {
+		session.wherem_Farthest_alt5_contents_seq1_seq0_star = map[int]peg.Result{}
+		session.whatm_Farthest_alt5_contents_seq1_seq0_star = map[int][]string{}
+	}
+	for key := range session.wherem_Farthest_alt5_contents_seq1_seq0_star {
+		delete(session.wherem_Farthest_alt5_contents_seq1_seq0_star, key)
+		delete(session.whatm_Farthest_alt5_contents_seq1_seq0_star, key)
+	}
+	if session.wherem_Farthest_alt6_contents == nil {
+		session.wherem_Farthest_alt6_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents {
+		delete(session.wherem_Farthest_alt6_contents, key)
+		delete(session.whatm_Farthest_alt6_contents, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq == nil {
+		session.wherem_Farthest_alt6_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq {
+		delete(session.wherem_Farthest_alt6_contents_seq, key)
+		delete(session.whatm_Farthest_alt6_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt6_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt6_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt6_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt6_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq1_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt6_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt6_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq1_seq0_alt == nil {
+		session.wherem_Farthest_alt6_contents_seq1_seq0_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq1_seq0_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq0_alt {
+		delete(session.wherem_Farthest_alt6_contents_seq1_seq0_alt, key)
+		delete(session.whatm_Farthest_alt6_contents_seq1_seq0_alt, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents == nil {
+		session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents {
+		delete(session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents, key)
+		delete(session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents, key)
+	}
+	if session.wherem_Farthest_alt6_contents_seq1_seq1_lit == nil {
+		session.wherem_Farthest_alt6_contents_seq1_seq1_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt6_contents_seq1_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq1_lit {
+		delete(session.wherem_Farthest_alt6_contents_seq1_seq1_lit, key)
+		delete(session.whatm_Farthest_alt6_contents_seq1_seq1_lit, key)
+	}
+	if session.wherem_Farthest_alt7_contents == nil {
+		session.wherem_Farthest_alt7_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt7_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt7_contents {
+		delete(session.wherem_Farthest_alt7_contents, key)
+		delete(session.whatm_Farthest_alt7_contents, key)
+	}
+	if session.wherem_Farthest_alt7_contents_seq == nil {
+		session.wherem_Farthest_alt7_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt7_contents_seq = map[int]struct {
+			V0 string
+			V1 struct {
+				V0 struct{}
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt7_contents_seq {
+		delete(session.wherem_Farthest_alt7_contents_seq, key)
+		delete(session.whatm_Farthest_alt7_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt7_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt7_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt7_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt7_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt7_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt7_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt7_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt7_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt7_contents_seq1_seq = map[int]struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt7_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt7_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt7_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt7_contents_seq1_seq0_not == nil {
+		session.wherem_Farthest_alt7_contents_seq1_seq0_not = map[int]peg.Result{}
+		session.whatm_Farthest_alt7_contents_seq1_seq0_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_Farthest_alt7_contents_seq1_seq0_not {
+		delete(session.wherem_Farthest_alt7_contents_seq1_seq0_not, key)
+		delete(session.whatm_Farthest_alt7_contents_seq1_seq0_not, key)
+	}
+	if session.wherem_Farthest_alt8_contents == nil {
+		session.wherem_Farthest_alt8_contents = map[int]peg.Result{}
+		session.whatm_Farthest_alt8_contents = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt8_contents {
+		delete(session.wherem_Farthest_alt8_contents, key)
+		delete(session.whatm_Farthest_alt8_contents, key)
+	}
+	if session.wherem_Farthest_alt8_contents_seq == nil {
+		session.wherem_Farthest_alt8_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt8_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt8_contents_seq {
+		delete(session.wherem_Farthest_alt8_contents_seq, key)
+		delete(session.whatm_Farthest_alt8_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt8_contents_seq0_lit == nil {
+		session.wherem_Farthest_alt8_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt8_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt8_contents_seq0_lit {
+		delete(session.wherem_Farthest_alt8_contents_seq0_lit, key)
+		delete(session.whatm_Farthest_alt8_contents_seq0_lit, key)
+	}
+	if session.wherem_Farthest_alt8_contents_seq1_alt == nil {
+		session.wherem_Farthest_alt8_contents_seq1_alt = map[int]peg.Result{}
+		session.whatm_Farthest_alt8_contents_seq1_alt = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt8_contents_seq1_alt {
+		delete(session.wherem_Farthest_alt8_contents_seq1_alt, key)
+		delete(session.whatm_Farthest_alt8_contents_seq1_alt, key)
+	}
+	if session.wherem_Farthest_alt8_contents_seq1_alt1_lit == nil {
+		session.wherem_Farthest_alt8_contents_seq1_alt1_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt8_contents_seq1_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt8_contents_seq1_alt1_lit {
+		delete(session.wherem_Farthest_alt8_contents_seq1_alt1_lit, key)
+		delete(session.whatm_Farthest_alt8_contents_seq1_alt1_lit, key)
+	}
+	if session.wherem_Items_star == nil {
+		session.wherem_Items_star = map[int]peg.Result{}
+		session.whatm_Items_star = map[int][]string{}
+	}
+	for key := range session.wherem_Items_star {
+		delete(session.wherem_Items_star, key)
+		delete(session.whatm_Items_star, key)
+	}
+	if session.wherem_Items_star_recover == nil {
+		session.wherem_Items_star_recover = map[int]peg.Result{}
+		session.whatm_Items_star_recover = map[int]string{}
+	}
+	for key := range session.wherem_Items_star_recover {
+		delete(session.wherem_Items_star_recover, key)
+		delete(session.whatm_Items_star_recover, key)
+	}
+	if session.wherem_Items_star_recover0_go == nil {
+		session.wherem_Items_star_recover0_go = map[int]peg.Result{}
+		session.whatm_Items_star_recover0_go = map[int]string{}
+	}
+	for key := range session.wherem_Items_star_recover0_go {
+		delete(session.wherem_Items_star_recover0_go, key)
+		delete(session.whatm_Items_star_recover0_go, key)
+	}
+	if session.wherem_Items_star_recover0_go_seq == nil {
+		session.wherem_Items_star_recover0_go_seq = map[int]peg.Result{}
+		session.whatm_Items_star_recover0_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Items_star_recover0_go_seq {
+		delete(session.wherem_Items_star_recover0_go_seq, key)
+		delete(session.whatm_Items_star_recover0_go_seq, key)
+	}
+	if session.wherem_Letters_star == nil {
+		session.wherem_Letters_star = map[int]peg.Result{}
+		session.whatm_Letters_star = map[int][]string{}
+	}
+	for key := range session.wherem_Letters_star {
+		delete(session.wherem_Letters_star, key)
+		delete(session.whatm_Letters_star, key)
+	}
+	if session.wherem_Letters_star_alt == nil {
+		session.wherem_Letters_star_alt = map[int]peg.Result{}
+		session.whatm_Letters_star_alt = map[int]string{}
+	}
+	for key := range session.wherem_Letters_star_alt {
+		delete(session.wherem_Letters_star_alt, key)
+		delete(session.whatm_Letters_star_alt, key)
+	}
+	if session.wherem_Letters_star_alt0_regex == nil {
+		session.wherem_Letters_star_alt0_regex = map[int]peg.Result{}
+		session.whatm_Letters_star_alt0_regex = map[int]string{}
+	}
+	for key := range session.wherem_Letters_star_alt0_regex {
+		delete(session.wherem_Letters_star_alt0_regex, key)
+		delete(session.whatm_Letters_star_alt0_regex, key)
+	}
+	if session.wherem_Letters_star_alt1_lit == nil {
+		session.wherem_Letters_star_alt1_lit = map[int]peg.Result{}
+		session.whatm_Letters_star_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Letters_star_alt1_lit {
+		delete(session.wherem_Letters_star_alt1_lit, key)
+		delete(session.whatm_Letters_star_alt1_lit, key)
+	}
+	if session.wherem_Nest_go == nil {
+		session.wherem_Nest_go = map[int]peg.Result{}
+		session.whatm_Nest_go = map[int]string{}
+	}
+	for key := range session.wherem_Nest_go {
+		delete(session.wherem_Nest_go, key)
+		delete(session.whatm_Nest_go, key)
+	}
+	if session.wherem_Nest_go_seq == nil {
+		session.wherem_Nest_go_seq = map[int]peg.Result{}
+		session.whatm_Nest_go_seq = map[int]struct {
+			V0 struct{}
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Nest_go_seq {
+		delete(session.wherem_Nest_go_seq, key)
+		delete(session.whatm_Nest_go_seq, key)
+	}
+	if session.wherem_Nest_go_seq0_not == nil {
+		session.wherem_Nest_go_seq0_not = map[int]peg.Result{}
+		session.whatm_Nest_go_seq0_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_Nest_go_seq0_not {
+		delete(session.wherem_Nest_go_seq0_not, key)
+		delete(session.whatm_Nest_go_seq0_not, key)
+	}
+	if session.wherem_Nest_go_seq0_not_seq == nil {
+		session.wherem_Nest_go_seq0_not_seq = map[int]peg.Result{}
+		session.whatm_Nest_go_seq0_not_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Nest_go_seq0_not_seq {
+		delete(session.wherem_Nest_go_seq0_not_seq, key)
+		delete(session.whatm_Nest_go_seq0_not_seq, key)
+	}
+	if session.wherem_Word_alt == nil {
+		session.wherem_Word_alt = map[int]peg.Result{}
+		session.whatm_Word_alt = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt {
+		delete(session.wherem_Word_alt, key)
+		delete(session.whatm_Word_alt, key)
+	}
+	if session.wherem_Word_alt0_go == nil {
+		session.wherem_Word_alt0_go = map[int]peg.Result{}
+		session.whatm_Word_alt0_go = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt0_go {
+		delete(session.wherem_Word_alt0_go, key)
+		delete(session.whatm_Word_alt0_go, key)
+	}
+	if session.wherem_Word_alt0_go_seq == nil {
+		session.wherem_Word_alt0_go_seq = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq = map[int]struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq {
+		delete(session.wherem_Word_alt0_go_seq, key)
+		delete(session.whatm_Word_alt0_go_seq, key)
+	}
+	if session.wherem_Word_alt0_go_seq0_not == nil {
+		session.wherem_Word_alt0_go_seq0_not = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq0_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq0_not {
+		delete(session.wherem_Word_alt0_go_seq0_not, key)
+		delete(session.whatm_Word_alt0_go_seq0_not, key)
+	}
+	if session.wherem_Word_alt0_go_seq0_not_seq == nil {
+		session.wherem_Word_alt0_go_seq0_not_seq = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq0_not_seq = map[int]struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq0_not_seq {
+		delete(session.wherem_Word_alt0_go_seq0_not_seq, key)
+		delete(session.whatm_Word_alt0_go_seq0_not_seq, key)
+	}
+	if session.wherem_Word_alt0_go_seq0_not_seq0_lit == nil {
+		session.wherem_Word_alt0_go_seq0_not_seq0_lit = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq0_not_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq0_not_seq0_lit {
+		delete(session.wherem_Word_alt0_go_seq0_not_seq0_lit, key)
+		delete(session.whatm_Word_alt0_go_seq0_not_seq0_lit, key)
+	}
+	if session.wherem_Word_alt0_go_seq1_and == nil {
+		session.wherem_Word_alt0_go_seq1_and = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq1_and = map[int]struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq1_and {
+		delete(session.wherem_Word_alt0_go_seq1_and, key)
+		delete(session.whatm_Word_alt0_go_seq1_and, key)
+	}
+	if session.wherem_Word_alt0_go_seq1_and_seq == nil {
+		session.wherem_Word_alt0_go_seq1_and_seq = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq1_and_seq = map[int]struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq {
+		delete(session.wherem_Word_alt0_go_seq1_and_seq, key)
+		delete(session.whatm_Word_alt0_go_seq1_and_seq, key)
+	}
+	if session.wherem_Word_alt0_go_seq1_and_seq0_regex == nil {
+		session.wherem_Word_alt0_go_seq1_and_seq0_regex = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq1_and_seq0_regex = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq0_regex {
+		delete(session.wherem_Word_alt0_go_seq1_and_seq0_regex, key)
+		delete(session.whatm_Word_alt0_go_seq1_and_seq0_regex, key)
+	}
+	if session.wherem_Word_alt0_go_seq2_regex == nil {
+		session.wherem_Word_alt0_go_seq2_regex = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq2_regex = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq2_regex {
+		delete(session.wherem_Word_alt0_go_seq2_regex, key)
+		delete(session.whatm_Word_alt0_go_seq2_regex, key)
+	}
+	if session.wherem_Word_alt1_lit == nil {
+		session.wherem_Word_alt1_lit = map[int]peg.Result{}
+		session.whatm_Word_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt1_lit {
+		delete(session.wherem_Word_alt1_lit, key)
+		delete(session.whatm_Word_alt1_lit, key)
+	}
+	if session.wherem_keyword_go_seq == nil {
+		session.wherem_keyword_go_seq = map[int]peg.Result{}
+		session.whatm_keyword_go_seq = map[int]struct {
+			V0 string
+			V1 struct{}
+		}{}
+	}
+	for key := range session.wherem_keyword_go_seq {
+		delete(session.wherem_keyword_go_seq, key)
+		delete(session.whatm_keyword_go_seq, key)
+	}
+	if session.wherem_keyword_go_seq0_alt == nil {
+		session.wherem_keyword_go_seq0_alt = map[int]peg.Result{}
+		session.whatm_keyword_go_seq0_alt = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq0_alt {
+		delete(session.wherem_keyword_go_seq0_alt, key)
+		delete(session.whatm_keyword_go_seq0_alt, key)
+	}
+	if session.wherem_keyword_go_seq0_alt0_lit == nil {
+		session.wherem_keyword_go_seq0_alt0_lit = map[int]peg.Result{}
+		session.whatm_keyword_go_seq0_alt0_lit = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq0_alt0_lit {
+		delete(session.wherem_keyword_go_seq0_alt0_lit, key)
+		delete(session.whatm_keyword_go_seq0_alt0_lit, key)
+	}
+	if session.wherem_keyword_go_seq0_alt1_lit == nil {
+		session.wherem_keyword_go_seq0_alt1_lit = map[int]peg.Result{}
+		session.whatm_keyword_go_seq0_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq0_alt1_lit {
+		delete(session.wherem_keyword_go_seq0_alt1_lit, key)
+		delete(session.whatm_keyword_go_seq0_alt1_lit, key)
+	}
+	if session.wherem_keyword_go_seq1_not == nil {
+		session.wherem_keyword_go_seq1_not = map[int]peg.Result{}
+		session.whatm_keyword_go_seq1_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_keyword_go_seq1_not {
+		delete(session.wherem_keyword_go_seq1_not, key)
+		delete(session.whatm_keyword_go_seq1_not, key)
+	}
+	if session.wherem_keyword_go_seq1_not_regex == nil {
+		session.wherem_keyword_go_seq1_not_regex = map[int]peg.Result{}
+		session.whatm_keyword_go_seq1_not_regex = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq1_not_regex {
+		delete(session.wherem_keyword_go_seq1_not_regex, key)
+		delete(session.whatm_keyword_go_seq1_not_regex, key)
+	}
+	if session.wherem_name_alias == nil {
+		session.wherem_name_alias = map[int]peg.Result{}
+		session.whatm_name_alias = map[int]string{}
+	}
+	for key := range session.wherem_name_alias {
+		delete(session.wherem_name_alias, key)
+		delete(session.whatm_name_alias, key)
+	}
+	if session.wherem_name_alias_go == nil {
+		session.wherem_name_alias_go = map[int]peg.Result{}
+		session.whatm_name_alias_go = map[int]string{}
+	}
+	for key := range session.wherem_name_alias_go {
+		delete(session.wherem_name_alias_go, key)
+		delete(session.whatm_name_alias_go, key)
+	}
+	if session.wherem_name_alias_go_seq == nil {
+		session.wherem_name_alias_go_seq = map[int]peg.Result{}
+		session.whatm_name_alias_go_seq = map[int]struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_name_alias_go_seq {
+		delete(session.wherem_name_alias_go_seq, key)
+		delete(session.whatm_name_alias_go_seq, key)
+	}
+	if session.wherem_name_alias_go_seq1_not == nil {
+		session.wherem_name_alias_go_seq1_not = map[int]peg.Result{}
+		session.whatm_name_alias_go_seq1_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_name_alias_go_seq1_not {
+		delete(session.wherem_name_alias_go_seq1_not, key)
+		delete(session.whatm_name_alias_go_seq1_not, key)
+	}
+	if session.wherem_name_alias_go_seq2_regex == nil {
+		session.wherem_name_alias_go_seq2_regex = map[int]peg.Result{}
+		session.whatm_name_alias_go_seq2_regex = map[int]string{}
+	}
+	for key := range session.wherem_name_alias_go_seq2_regex {
+		delete(session.wherem_name_alias_go_seq2_regex, key)
+		delete(session.whatm_name_alias_go_seq2_regex, key)
+	}
+	if session.wherem_nest_alt == nil {
+		session.wherem_nest_alt = map[int]peg.Result{}
+		session.whatm_nest_alt = map[int]string{}
+	}
+	for key := range session.wherem_nest_alt {
+		delete(session.wherem_nest_alt, key)
+		delete(session.whatm_nest_alt, key)
+	}
+	if session.wherem_nest_alt0_go == nil {
+		session.wherem_nest_alt0_go = map[int]peg.Result{}
+		session.whatm_nest_alt0_go = map[int]string{}
+	}
+	for key := range session.wherem_nest_alt0_go {
+		delete(session.wherem_nest_alt0_go, key)
+		delete(session.whatm_nest_alt0_go, key)
+	}
+	if session.wherem_nest_alt0_go_seq == nil {
+		session.wherem_nest_alt0_go_seq = map[int]peg.Result{}
+		session.whatm_nest_alt0_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_nest_alt0_go_seq {
+		delete(session.wherem_nest_alt0_go_seq, key)
+		delete(session.whatm_nest_alt0_go_seq, key)
+	}
+	if session.wherem_nest_alt1_go == nil {
+		session.wherem_nest_alt1_go = map[int]peg.Result{}
+		session.whatm_nest_alt1_go = map[int]string{}
+	}
+	for key := range session.wherem_nest_alt1_go {
+		delete(session.wherem_nest_alt1_go, key)
+		delete(session.whatm_nest_alt1_go, key)
+	}
+	if session.wherem_nest_alt1_go_seq == nil {
+		session.wherem_nest_alt1_go_seq = map[int]peg.Result{}
+		session.whatm_nest_alt1_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_nest_alt1_go_seq {
+		delete(session.wherem_nest_alt1_go_seq, key)
+		delete(session.whatm_nest_alt1_go_seq, key)
+	}
+	if session.wherem_nest_alt1_go_seq2_lit == nil {
+		session.wherem_nest_alt1_go_seq2_lit = map[int]peg.Result{}
+		session.whatm_nest_alt1_go_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_nest_alt1_go_seq2_lit {
+		delete(session.wherem_nest_alt1_go_seq2_lit, key)
+		delete(session.whatm_nest_alt1_go_seq2_lit, key)
+	}
+	if session.wherem_number_go == nil {
+		session.wherem_number_go = map[int]peg.Result{}
+		session.whatm_number_go = map[int]string{}
+	}
+	for key := range session.wherem_number_go {
+		delete(session.wherem_number_go, key)
+		delete(session.whatm_number_go, key)
+	}
+	if session.wherem_number_go_seq == nil {
+		session.wherem_number_go_seq = map[int]peg.Result{}
+		session.whatm_number_go_seq = map[int]struct {
+			V0 string
+			V1 float64
+		}{}
+	}
+	for key := range session.wherem_number_go_seq {
+		delete(session.wherem_number_go_seq, key)
+		delete(session.whatm_number_go_seq, key)
+	}
+	if session.wherem_number_go_seq1_alias == nil {
+		session.wherem_number_go_seq1_alias = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias = map[int]float64{}
+	}
+	for key := range session.wherem_number_go_seq1_alias {
+		delete(session.wherem_number_go_seq1_alias, key)
+		delete(session.whatm_number_go_seq1_alias, key)
+	}
+	if session.wherem_number_go_seq1_alias_try == nil {
+		session.wherem_number_go_seq1_alias_try = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try = map[int]float64{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try {
+		delete(session.wherem_number_go_seq1_alias_try, key)
+		delete(session.whatm_number_go_seq1_alias_try, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents == nil {
+		session.wherem_number_go_seq1_alias_try_contents = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents {
+		delete(session.wherem_number_go_seq1_alias_try_contents, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq = map[int]struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq0_and == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq0_and = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq0_and = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq1_plus == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq1_plus = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq1_plus = map[int][]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq1_plus {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq1_plus, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq1_plus, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq2_opt == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq2_opt = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq2_opt = map[int]*struct {
+			V0 string
+			V1 []string
+		}{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq = map[int]struct {
+			V0 string
+			V1 []string
+		}{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq3_opt == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq3_opt = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq3_opt = map[int]*string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq3_opt {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq3_opt, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq3_opt, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex, key)
+	}
+	if session.wherem_space_regex == nil {
+		session.wherem_space_regex = map[int]peg.Result{}
+		session.whatm_space_regex = map[int]string{}
+	}
+	for key := range session.wherem_space_regex {
+		delete(session.wherem_space_regex, key)
+		delete(session.whatm_space_regex, key)
+	}
+	if session.wherem_statement_alt == nil {
+		session.wherem_statement_alt = map[int]peg.Result{}
+		session.whatm_statement_alt = map[int]string{}
+	}
+	for key := range session.wherem_statement_alt {
+		delete(session.wherem_statement_alt, key)
+		delete(session.whatm_statement_alt, key)
+	}
+	if session.wherem_statement_alt0_go == nil {
+		session.wherem_statement_alt0_go = map[int]peg.Result{}
+		session.whatm_statement_alt0_go = map[int]string{}
+	}
+	for key := range session.wherem_statement_alt0_go {
+		delete(session.wherem_statement_alt0_go, key)
+		delete(session.whatm_statement_alt0_go, key)
+	}
+	if session.wherem_statement_alt0_go_seq == nil {
+		session.wherem_statement_alt0_go_seq = map[int]peg.Result{}
+		session.whatm_statement_alt0_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	for key := range session.wherem_statement_alt0_go_seq {
+		delete(session.wherem_statement_alt0_go_seq, key)
+		delete(session.whatm_statement_alt0_go_seq, key)
+	}
+	if session.wherem_statement_alt0_go_seq3_cut == nil {
+		session.wherem_statement_alt0_go_seq3_cut = map[int]peg.Result{}
+		session.whatm_statement_alt0_go_seq3_cut = map[int]struct{}{}
+	}
+	for key := range session.wherem_statement_alt0_go_seq3_cut {
+		delete(session.wherem_statement_alt0_go_seq3_cut, key)
+		delete(session.whatm_statement_alt0_go_seq3_cut, key)
+	}
+	if session.wherem_statement_alt0_go_seq6_label == nil {
+		session.wherem_statement_alt0_go_seq6_label = map[int]peg.Result{}
+		session.whatm_statement_alt0_go_seq6_label = map[int]string{}
+	}
+	for key := range session.wherem_statement_alt0_go_seq6_label {
+		delete(session.wherem_statement_alt0_go_seq6_label, key)
+		delete(session.whatm_statement_alt0_go_seq6_label, key)
+	}
+	if session.wherem_statement_alt0_go_seq6_label_lit == nil {
+		session.wherem_statement_alt0_go_seq6_label_lit = map[int]peg.Result{}
+		session.whatm_statement_alt0_go_seq6_label_lit = map[int]string{}
+	}
+	for key := range session.wherem_statement_alt0_go_seq6_label_lit {
+		delete(session.wherem_statement_alt0_go_seq6_label_lit, key)
+		delete(session.whatm_statement_alt0_go_seq6_label_lit, key)
+	}
+	if session.wherem_statement_alt1_go == nil {
+		session.wherem_statement_alt1_go = map[int]peg.Result{}
+		session.whatm_statement_alt1_go = map[int]string{}
+	}
+	for key := range session.wherem_statement_alt1_go {
+		delete(session.wherem_statement_alt1_go, key)
+		delete(session.whatm_statement_alt1_go, key)
+	}
+	if session.wherem_statement_alt1_go_seq == nil {
+		session.wherem_statement_alt1_go_seq = map[int]peg.Result{}
+		session.whatm_statement_alt1_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	for key := range session.wherem_statement_alt1_go_seq {
+		delete(session.wherem_statement_alt1_go_seq, key)
+		delete(session.whatm_statement_alt1_go_seq, key)
+	}
+	if session.wherem_statement_alt1_go_seq4_plus == nil {
+		session.wherem_statement_alt1_go_seq4_plus = map[int]peg.Result{}
+		session.whatm_statement_alt1_go_seq4_plus = map[int][]string{}
+	}
+	for key := range session.wherem_statement_alt1_go_seq4_plus {
+		delete(session.wherem_statement_alt1_go_seq4_plus, key)
+		delete(session.whatm_statement_alt1_go_seq4_plus, key)
+	}
+	if session.wherem_value_alt == nil {
+		session.wherem_value_alt = map[int]peg.Result{}
+		session.whatm_value_alt = map[int]string{}
+	}
+	for key := range session.wherem_value_alt {
+		delete(session.wherem_value_alt, key)
+		delete(session.whatm_value_alt, key)
+	}
+	if session.wherem_value_alt2_go == nil {
+		session.wherem_value_alt2_go = map[int]peg.Result{}
+		session.whatm_value_alt2_go = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go {
+		delete(session.wherem_value_alt2_go, key)
+		delete(session.whatm_value_alt2_go, key)
+	}
+	if session.wherem_value_alt2_go_seq == nil {
+		session.wherem_value_alt2_go_seq = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	for key := range session.wherem_value_alt2_go_seq {
+		delete(session.wherem_value_alt2_go_seq, key)
+		delete(session.whatm_value_alt2_go_seq, key)
+	}
+	if session.wherem_value_alt2_go_seq1_lit == nil {
+		session.wherem_value_alt2_go_seq1_lit = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go_seq1_lit {
+		delete(session.wherem_value_alt2_go_seq1_lit, key)
+		delete(session.whatm_value_alt2_go_seq1_lit, key)
+	}
+	if session.wherem_value_alt2_go_seq4_label == nil {
+		session.wherem_value_alt2_go_seq4_label = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq4_label = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go_seq4_label {
+		delete(session.wherem_value_alt2_go_seq4_label, key)
+		delete(session.whatm_value_alt2_go_seq4_label, key)
+	}
+	if session.wherem_value_alt2_go_seq4_label_lit == nil {
+		session.wherem_value_alt2_go_seq4_label_lit = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq4_label_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go_seq4_label_lit {
+		delete(session.wherem_value_alt2_go_seq4_label_lit, key)
+		delete(session.whatm_value_alt2_go_seq4_label_lit, key)
+	}
+	if session.wherem_value_alt3_try == nil {
+		session.wherem_value_alt3_try = map[int]peg.Result{}
+		session.whatm_value_alt3_try = map[int]string{}
+	}
+	for key := range session.wherem_value_alt3_try {
+		delete(session.wherem_value_alt3_try, key)
+		delete(session.whatm_value_alt3_try, key)
+	}
+	if session.wherem_value_alt3_try_seq == nil {
+		session.wherem_value_alt3_try_seq = map[int]peg.Result{}
+		session.whatm_value_alt3_try_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_value_alt3_try_seq {
+		delete(session.wherem_value_alt3_try_seq, key)
+		delete(session.whatm_value_alt3_try_seq, key)
+	}
+	if session.wherem_value_alt3_try_seq1_lit == nil {
+		session.wherem_value_alt3_try_seq1_lit = map[int]peg.Result{}
+		session.whatm_value_alt3_try_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt3_try_seq1_lit {
+		delete(session.wherem_value_alt3_try_seq1_lit, key)
+		delete(session.whatm_value_alt3_try_seq1_lit, key)
+	}
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	session.memos = 0
+	for key := range session.wherem_Doc_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go, key)
+			delete(session.whatm_Doc_go, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go)
+	for key := range session.wherem_Doc_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq, key)
+			delete(session.whatm_Doc_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq)
+	for key := range session.wherem_Doc_go_seq0_star {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star, key)
+			delete(session.whatm_Doc_go_seq0_star, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star)
+	for key := range session.wherem_Doc_go_seq0_star_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star_go, key)
+			delete(session.whatm_Doc_go_seq0_star_go, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star_go)
+	for key := range session.wherem_Doc_go_seq0_star_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star_go_seq, key)
+			delete(session.whatm_Doc_go_seq0_star_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star_go_seq)
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star_go_seq0_recover, key)
+			delete(session.whatm_Doc_go_seq0_star_go_seq0_recover, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star_go_seq0_recover)
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+			delete(session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit)
+	for key := range session.wherem_Farthest_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt, key)
+			delete(session.whatm_Farthest_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt)
+	for key := range session.wherem_Farthest_alt0_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents, key)
+			delete(session.whatm_Farthest_alt0_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents)
+	for key := range session.wherem_Farthest_alt0_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq, key)
+			delete(session.whatm_Farthest_alt0_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq)
+	for key := range session.wherem_Farthest_alt0_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt0_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq1_lit, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq1_lit)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq2_lit, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq2_lit)
+	for key := range session.wherem_Farthest_alt1_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt1_contents, key)
+			delete(session.whatm_Farthest_alt1_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt1_contents)
+	for key := range session.wherem_Farthest_alt1_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt1_contents_seq, key)
+			delete(session.whatm_Farthest_alt1_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt1_contents_seq)
+	for key := range session.wherem_Farthest_alt1_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt1_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt1_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt1_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt1_contents_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt1_contents_seq1_alt, key)
+			delete(session.whatm_Farthest_alt1_contents_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt1_contents_seq1_alt)
+	for key := range session.wherem_Farthest_alt2_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents, key)
+			delete(session.whatm_Farthest_alt2_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents)
+	for key := range session.wherem_Farthest_alt2_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq, key)
+			delete(session.whatm_Farthest_alt2_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq)
+	for key := range session.wherem_Farthest_alt2_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt2_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt0_contents)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt1_contents)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt2_lit, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt2_lit)
+	for key := range session.wherem_Farthest_alt3_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents, key)
+			delete(session.whatm_Farthest_alt3_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents)
+	for key := range session.wherem_Farthest_alt3_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq, key)
+			delete(session.whatm_Farthest_alt3_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq)
+	for key := range session.wherem_Farthest_alt3_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt3_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt3_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq0_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq1_seq0_alt, key)
+			delete(session.whatm_Farthest_alt3_contents_seq1_seq0_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq1_seq0_alt)
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt, key)
+			delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq1_seq1_alt)
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents, key)
+			delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents)
+	for key := range session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq, key)
+			delete(session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq)
+	for key := range session.wherem_Farthest_alt4_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents, key)
+			delete(session.whatm_Farthest_alt4_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents)
+	for key := range session.wherem_Farthest_alt4_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq, key)
+			delete(session.whatm_Farthest_alt4_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq)
+	for key := range session.wherem_Farthest_alt4_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt4_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq1_alt, key)
+			delete(session.whatm_Farthest_alt4_contents_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq1_alt)
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt0_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq1_alt0_seq, key)
+			delete(session.whatm_Farthest_alt4_contents_seq1_alt0_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq1_alt0_seq)
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt, key)
+			delete(session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt)
+	for key := range session.wherem_Farthest_alt4_contents_seq1_alt1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt4_contents_seq1_alt1_seq, key)
+			delete(session.whatm_Farthest_alt4_contents_seq1_alt1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt4_contents_seq1_alt1_seq)
+	for key := range session.wherem_Farthest_alt5_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt5_contents, key)
+			delete(session.whatm_Farthest_alt5_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt5_contents)
+	for key := range session.wherem_Farthest_alt5_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt5_contents_seq, key)
+			delete(session.whatm_Farthest_alt5_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt5_contents_seq)
+	for key := range session.wherem_Farthest_alt5_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt5_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt5_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt5_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt5_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt5_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt5_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt5_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt5_contents_seq1_seq0_star {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt5_contents_seq1_seq0_star, key)
+			delete(session.whatm_Farthest_alt5_contents_seq1_seq0_star, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt5_contents_seq1_seq0_star)
+	for key := range session.wherem_Farthest_alt6_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents, key)
+			delete(session.whatm_Farthest_alt6_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents)
+	for key := range session.wherem_Farthest_alt6_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq, key)
+			delete(session.whatm_Farthest_alt6_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq)
+	for key := range session.wherem_Farthest_alt6_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt6_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt6_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq0_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq1_seq0_alt, key)
+			delete(session.whatm_Farthest_alt6_contents_seq1_seq0_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq1_seq0_alt)
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents, key)
+			delete(session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents)
+	for key := range session.wherem_Farthest_alt6_contents_seq1_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt6_contents_seq1_seq1_lit, key)
+			delete(session.whatm_Farthest_alt6_contents_seq1_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt6_contents_seq1_seq1_lit)
+	for key := range session.wherem_Farthest_alt7_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt7_contents, key)
+			delete(session.whatm_Farthest_alt7_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt7_contents)
+	for key := range session.wherem_Farthest_alt7_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt7_contents_seq, key)
+			delete(session.whatm_Farthest_alt7_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt7_contents_seq)
+	for key := range session.wherem_Farthest_alt7_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt7_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt7_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt7_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt7_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt7_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt7_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt7_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt7_contents_seq1_seq0_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt7_contents_seq1_seq0_not, key)
+			delete(session.whatm_Farthest_alt7_contents_seq1_seq0_not, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt7_contents_seq1_seq0_not)
+	for key := range session.wherem_Farthest_alt8_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt8_contents, key)
+			delete(session.whatm_Farthest_alt8_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt8_contents)
+	for key := range session.wherem_Farthest_alt8_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt8_contents_seq, key)
+			delete(session.whatm_Farthest_alt8_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt8_contents_seq)
+	for key := range session.wherem_Farthest_alt8_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt8_contents_seq0_lit, key)
+			delete(session.whatm_Farthest_alt8_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt8_contents_seq0_lit)
+	for key := range session.wherem_Farthest_alt8_contents_seq1_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt8_contents_seq1_alt, key)
+			delete(session.whatm_Farthest_alt8_contents_seq1_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt8_contents_seq1_alt)
+	for key := range session.wherem_Farthest_alt8_contents_seq1_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt8_contents_seq1_alt1_lit, key)
+			delete(session.whatm_Farthest_alt8_contents_seq1_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt8_contents_seq1_alt1_lit)
+	for key := range session.wherem_Items_star {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Items_star, key)
+			delete(session.whatm_Items_star, key)
+		}
+	}
+	session.memos += len(session.wherem_Items_star)
+	for key := range session.wherem_Items_star_recover {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Items_star_recover, key)
+			delete(session.whatm_Items_star_recover, key)
+		}
+	}
+	session.memos += len(session.wherem_Items_star_recover)
+	for key := range session.wherem_Items_star_recover0_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Items_star_recover0_go, key)
+			delete(session.whatm_Items_star_recover0_go, key)
+		}
+	}
+	session.memos += len(session.wherem_Items_star_recover0_go)
+	for key := range session.wherem_Items_star_recover0_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Items_star_recover0_go_seq, key)
+			delete(session.whatm_Items_star_recover0_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Items_star_recover0_go_seq)
+	for key := range session.wherem_Letters_star {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters_star, key)
+			delete(session.whatm_Letters_star, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters_star)
+	for key := range session.wherem_Letters_star_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters_star_alt, key)
+			delete(session.whatm_Letters_star_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters_star_alt)
+	for key := range session.wherem_Letters_star_alt0_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters_star_alt0_regex, key)
+			delete(session.whatm_Letters_star_alt0_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters_star_alt0_regex)
+	for key := range session.wherem_Letters_star_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters_star_alt1_lit, key)
+			delete(session.whatm_Letters_star_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters_star_alt1_lit)
+	for key := range session.wherem_Nest_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Nest_go, key)
+			delete(session.whatm_Nest_go, key)
+		}
+	}
+	session.memos += len(session.wherem_Nest_go)
+	for key := range session.wherem_Nest_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Nest_go_seq, key)
+			delete(session.whatm_Nest_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Nest_go_seq)
+	for key := range session.wherem_Nest_go_seq0_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Nest_go_seq0_not, key)
+			delete(session.whatm_Nest_go_seq0_not, key)
+		}
+	}
+	session.memos += len(session.wherem_Nest_go_seq0_not)
+	for key := range session.wherem_Nest_go_seq0_not_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Nest_go_seq0_not_seq, key)
+			delete(session.whatm_Nest_go_seq0_not_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Nest_go_seq0_not_seq)
+	for key := range session.wherem_Word_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt, key)
+			delete(session.whatm_Word_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt)
+	for key := range session.wherem_Word_alt0_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go, key)
+			delete(session.whatm_Word_alt0_go, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go)
+	for key := range session.wherem_Word_alt0_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq, key)
+			delete(session.whatm_Word_alt0_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq)
+	for key := range session.wherem_Word_alt0_go_seq0_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq0_not, key)
+			delete(session.whatm_Word_alt0_go_seq0_not, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq0_not)
+	for key := range session.wherem_Word_alt0_go_seq0_not_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq0_not_seq, key)
+			delete(session.whatm_Word_alt0_go_seq0_not_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq0_not_seq)
+	for key := range session.wherem_Word_alt0_go_seq0_not_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq0_not_seq0_lit, key)
+			delete(session.whatm_Word_alt0_go_seq0_not_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq0_not_seq0_lit)
+	for key := range session.wherem_Word_alt0_go_seq1_and {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq1_and, key)
+			delete(session.whatm_Word_alt0_go_seq1_and, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq1_and)
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq1_and_seq, key)
+			delete(session.whatm_Word_alt0_go_seq1_and_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq1_and_seq)
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq0_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq1_and_seq0_regex, key)
+			delete(session.whatm_Word_alt0_go_seq1_and_seq0_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq1_and_seq0_regex)
+	for key := range session.wherem_Word_alt0_go_seq2_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq2_regex, key)
+			delete(session.whatm_Word_alt0_go_seq2_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq2_regex)
+	for key := range session.wherem_Word_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt1_lit, key)
+			delete(session.whatm_Word_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt1_lit)
+	for key := range session.wherem_keyword_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq, key)
+			delete(session.whatm_keyword_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq)
+	for key := range session.wherem_keyword_go_seq0_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq0_alt, key)
+			delete(session.whatm_keyword_go_seq0_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq0_alt)
+	for key := range session.wherem_keyword_go_seq0_alt0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq0_alt0_lit, key)
+			delete(session.whatm_keyword_go_seq0_alt0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq0_alt0_lit)
+	for key := range session.wherem_keyword_go_seq0_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq0_alt1_lit, key)
+			delete(session.whatm_keyword_go_seq0_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq0_alt1_lit)
+	for key := range session.wherem_keyword_go_seq1_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq1_not, key)
+			delete(session.whatm_keyword_go_seq1_not, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq1_not)
+	for key := range session.wherem_keyword_go_seq1_not_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq1_not_regex, key)
+			delete(session.whatm_keyword_go_seq1_not_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq1_not_regex)
+	for key := range session.wherem_name_alias {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name_alias, key)
+			delete(session.whatm_name_alias, key)
+		}
+	}
+	session.memos += len(session.wherem_name_alias)
+	for key := range session.wherem_name_alias_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name_alias_go, key)
+			delete(session.whatm_name_alias_go, key)
+		}
+	}
+	session.memos += len(session.wherem_name_alias_go)
+	for key := range session.wherem_name_alias_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name_alias_go_seq, key)
+			delete(session.whatm_name_alias_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_name_alias_go_seq)
+	for key := range session.wherem_name_alias_go_seq1_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name_alias_go_seq1_not, key)
+			delete(session.whatm_name_alias_go_seq1_not, key)
+		}
+	}
+	session.memos += len(session.wherem_name_alias_go_seq1_not)
+	for key := range session.wherem_name_alias_go_seq2_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name_alias_go_seq2_regex, key)
+			delete(session.whatm_name_alias_go_seq2_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_name_alias_go_seq2_regex)
+	for key := range session.wherem_nest_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt, key)
+			delete(session.whatm_nest_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt)
+	for key := range session.wherem_nest_alt0_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt0_go, key)
+			delete(session.whatm_nest_alt0_go, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt0_go)
+	for key := range session.wherem_nest_alt0_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt0_go_seq, key)
+			delete(session.whatm_nest_alt0_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt0_go_seq)
+	for key := range session.wherem_nest_alt1_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt1_go, key)
+			delete(session.whatm_nest_alt1_go, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt1_go)
+	for key := range session.wherem_nest_alt1_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt1_go_seq, key)
+			delete(session.whatm_nest_alt1_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt1_go_seq)
+	for key := range session.wherem_nest_alt1_go_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest_alt1_go_seq2_lit, key)
+			delete(session.whatm_nest_alt1_go_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_nest_alt1_go_seq2_lit)
+	for key := range session.wherem_number_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go, key)
+			delete(session.whatm_number_go, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go)
+	for key := range session.wherem_number_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq, key)
+			delete(session.whatm_number_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq)
+	for key := range session.wherem_number_go_seq1_alias {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias, key)
+			delete(session.whatm_number_go_seq1_alias, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias)
+	for key := range session.wherem_number_go_seq1_alias_try {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try, key)
+			delete(session.whatm_number_go_seq1_alias_try, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try)
+	for key := range session.wherem_number_go_seq1_alias_try_contents {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq0_and)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq1_plus {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq1_plus, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq1_plus, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq1_plus)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq2_opt)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq3_opt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq3_opt, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq3_opt, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq3_opt)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex)
+	for key := range session.wherem_space_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_space_regex, key)
+			delete(session.whatm_space_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_space_regex)
+	for key := range session.wherem_statement_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt, key)
+			delete(session.whatm_statement_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt)
+	for key := range session.wherem_statement_alt0_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go, key)
+			delete(session.whatm_statement_alt0_go, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go)
+	for key := range session.wherem_statement_alt0_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go_seq, key)
+			delete(session.whatm_statement_alt0_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go_seq)
+	for key := range session.wherem_statement_alt0_go_seq3_cut {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go_seq3_cut, key)
+			delete(session.whatm_statement_alt0_go_seq3_cut, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go_seq3_cut)
+	for key := range session.wherem_statement_alt0_go_seq6_label {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go_seq6_label, key)
+			delete(session.whatm_statement_alt0_go_seq6_label, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go_seq6_label)
+	for key := range session.wherem_statement_alt0_go_seq6_label_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go_seq6_label_lit, key)
+			delete(session.whatm_statement_alt0_go_seq6_label_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go_seq6_label_lit)
+	for key := range session.wherem_statement_alt1_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt1_go, key)
+			delete(session.whatm_statement_alt1_go, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt1_go)
+	for key := range session.wherem_statement_alt1_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt1_go_seq, key)
+			delete(session.whatm_statement_alt1_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt1_go_seq)
+	for key := range session.wherem_statement_alt1_go_seq4_plus {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt1_go_seq4_plus, key)
+			delete(session.whatm_statement_alt1_go_seq4_plus, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt1_go_seq4_plus)
+	for key := range session.wherem_value_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt, key)
+			delete(session.whatm_value_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt)
+	for key := range session.wherem_value_alt2_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go, key)
+			delete(session.whatm_value_alt2_go, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go)
+	for key := range session.wherem_value_alt2_go_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq, key)
+			delete(session.whatm_value_alt2_go_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq)
+	for key := range session.wherem_value_alt2_go_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq1_lit, key)
+			delete(session.whatm_value_alt2_go_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq1_lit)
+	for key := range session.wherem_value_alt2_go_seq4_label {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq4_label, key)
+			delete(session.whatm_value_alt2_go_seq4_label, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq4_label)
+	for key := range session.wherem_value_alt2_go_seq4_label_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq4_label_lit, key)
+			delete(session.whatm_value_alt2_go_seq4_label_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq4_label_lit)
+	for key := range session.wherem_value_alt3_try {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt3_try, key)
+			delete(session.whatm_value_alt3_try, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt3_try)
+	for key := range session.wherem_value_alt3_try_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt3_try_seq, key)
+			delete(session.whatm_value_alt3_try_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt3_try_seq)
+	for key := range session.wherem_value_alt3_try_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt3_try_seq1_lit, key)
+			delete(session.whatm_value_alt3_try_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt3_try_seq1_lit)
+}
+
+// Doc parses the whole input as Doc. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Doc() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Doc(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// DocPrefix parses as much of the input as Doc matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Doc.
+func (session *Session) DocPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Doc(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Farthest parses the whole input as Farthest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Farthest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Farthest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// FarthestPrefix parses as much of the input as Farthest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Farthest.
+func (session *Session) FarthestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Farthest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Items parses the whole input as Items. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Items() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Items(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ItemsPrefix parses as much of the input as Items matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Items.
+func (session *Session) ItemsPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Items(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Letters parses the whole input as Letters. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Letters() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Letters(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// LettersPrefix parses as much of the input as Letters matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Letters.
+func (session *Session) LettersPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Letters(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Nest parses the whole input as Nest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Nest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Nest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NestPrefix parses as much of the input as Nest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Nest.
+func (session *Session) NestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Nest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Number parses the whole input as Number. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Number() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Number(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NumberPrefix parses as much of the input as Number matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Number.
+func (session *Session) NumberPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Number(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Word parses the whole input as Word. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Word() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Word(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// WordPrefix parses as much of the input as Word matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Word.
+func (session *Session) WordPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Word(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+var resourcem_Letters_star_alt0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:b)")
+var resourcem_Word_alt0_go_seq1_and_seq0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z])")
+var resourcem_Word_alt0_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z]+)")
+var resourcem_keyword_go_seq1_not_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z0-9])")
+var resourcem_name_alias_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z][a-z0-9]*)")
+var resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[0-9])")
+var resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:e[0-9]+)")
+var resourcem_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[ \\t\\r\\n]*)")
+
+// Below is the internal generated parse structure.
+// It's not very efficient right now, but is accomplishes parsing in linear time.
+
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
+}
+
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
+}
+
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
+	}
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
+	}
+	return nil
+}
+
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
+}
+
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
+}
+
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
+	}
+	return here
+}
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
+	}
+	return session.fill(here, to)
+}
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
+	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
+}
+
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
+}
+
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
+}
+
+func (session *Session) m_Doc(here int) (peg.Result, []string) {
+	session.enter(here)
+	result, value := session.dm_Doc(here)
+	session.depth--
+	return result, value
+}
+
+// root Doc
+func (session *Session) dm_Doc(here int) (peg.Result, []string) {
+	return session.m_Doc_go(here)
+}
+
+func (session *Session) m_Doc_go(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Doc_go[here]; ok {
+		return result, session.whatm_Doc_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go[key]; ok {
+			return result, session.whatm_Doc_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go(here)
+	session.depth--
+	session.wherem_Doc_go[key] = result
+	session.whatm_Doc_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (recover (root statement) until (";") root space ";" go string { arg.V0 })* root space go []string { arg.V0 }
+func (session *Session) dm_Doc_go(here int) (peg.Result, []string) {
+	check, value := session.m_Doc_go_seq(here)
+	if !check.Ok {
+		var zero []string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 []string
+		V1 string
+	}) []string {
+		return arg.V0
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_Doc_go_seq(here int) (peg.Result, struct {
+	V0 []string
+	V1 string
+}) {
+	if result, ok := session.wherem_Doc_go_seq[here]; ok {
+		return result, session.whatm_Doc_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq[key]; ok {
+			return result, session.whatm_Doc_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq(here)
+	session.depth--
+	session.wherem_Doc_go_seq[key] = result
+	session.whatm_Doc_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (recover (root statement) until (";") root space ";" go string { arg.V0 })* root space
+func (session *Session) dm_Doc_go_seq(here int) (peg.Result, struct {
+	V0 []string
+	V1 string
+}) {
+	result := struct {
+		V0 []string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Doc_go_seq0_star(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Doc_go_seq0_star(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Doc_go_seq0_star[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star[key] = result
+	session.whatm_Doc_go_seq0_star[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (recover (root statement) until (";") root space ";" go string { arg.V0 })*
+func (session *Session) dm_Doc_go_seq0_star(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_Doc_go_seq0_star_go(here)
+		if next.Fatal {
+			return next, nil
+		}
+		if !next.Ok {
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_Doc_go_seq0_star_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Doc_go_seq0_star_go[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star_go[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star_go[key] = result
+	session.whatm_Doc_go_seq0_star_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// recover (root statement) until (";") root space ";" go string { arg.V0 }
+func (session *Session) dm_Doc_go_seq0_star_go(here int) (peg.Result, string) {
+	check, value := session.m_Doc_go_seq0_star_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 string
+	}) string {
+		return arg.V0
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_Doc_go_seq0_star_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Doc_go_seq0_star_go_seq[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star_go_seq[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go_seq(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star_go_seq[key] = result
+	session.whatm_Doc_go_seq0_star_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// recover (root statement) until (";") root space ";"
+func (session *Session) dm_Doc_go_seq0_star_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Doc_go_seq0_star_go_seq0_recover(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Doc_go_seq0_star_go_seq0_recover(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star_go_seq0_recover[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star_go_seq0_recover[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go_seq0_recover(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star_go_seq0_recover[key] = result
+	session.whatm_Doc_go_seq0_star_go_seq0_recover[key] = value
+	session.count(here)
+	return result, value
+}
+
+// recover (root statement) until (";")
+func (session *Session) dm_Doc_go_seq0_star_go_seq0_recover(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_statement(here)
+	if check.Ok || session.failures.Silent != 0 {
+		return check, value
+	}
+	recovered := session.failure(check)
+	session.failures.Clear()
+	at := here
+	for session.available(here, at+1) {
+		session.failures.Silent++
+		sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+		session.failures.Silent--
+		if sync.Ok {
+			break
+		}
+		at = session.next(at)
+	}
+	var placeholder string = "?"
+	return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+}
+
+func (session *Session) m_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go_seq0_recover1_lit(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[key] = result
+	session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ";"
+func (session *Session) dm_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ";" {
+		return session.failures.Fail(here, peg.Expected{Token: ";"}), ""
+	}
+	return peg.Success(here + 1), ";"
+}
+
+func (session *Session) m_Farthest(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_Farthest(here)
+	session.depth--
+	return result, value
+}
+
+// root Farthest
+func (session *Session) dm_Farthest(here int) (peg.Result, string) {
+	return session.m_Farthest_alt(here)
+}
+
+func (session *Session) m_Farthest_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt[here]; ok {
+		return result, session.whatm_Farthest_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt[key]; ok {
+			return result, session.whatm_Farthest_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt[key] = result
+	session.whatm_Farthest_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (contents { "1" "a" "b" "c" } / contents { "2" ("a" / "b" / regex "[0-9]") } / contents { "3" (contents { "a" "b" } / contents { "a" "c" "d" } / "e") } / contents { "4" ("a" / "b") (contents { "c" "d" } / "e") } / contents { "5" ("a" ("b" / "c") / "a" "d") } / contents { "6" ("a")* "b" } / contents { "7" (contents { "a" "b" "c" } / "a") "z" } / contents { "8" not ("a" "b") "a" "c" } / contents { "9" ("a" / "ab") })
+func (session *Session) dm_Farthest_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt0_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt1_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt2_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt3_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt4_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt5_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt6_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt7_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt8_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt0_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents[key] = result
+	session.whatm_Farthest_alt0_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "1" "a" "b" "c" }
+func (session *Session) dm_Farthest_alt0_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt0_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq[key] = result
+	session.whatm_Farthest_alt0_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "1" "a" "b" "c"
+func (session *Session) dm_Farthest_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt0_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt0_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "1"
+func (session *Session) dm_Farthest_alt0_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "1" {
+		return session.failures.Fail(here, peg.Expected{Token: "1"}), ""
+	}
+	return peg.Success(here + 1), "1"
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "b" "c"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq1_lit[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq1_lit[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq1_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq1_lit[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "b"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "b" {
+		return session.failures.Fail(here, peg.Expected{Token: "b"}), ""
+	}
+	return peg.Success(here + 1), "b"
+}
+
+func (session *Session) m_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq2_lit[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "c"
+func (session *Session) dm_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "c" {
+		return session.failures.Fail(here, peg.Expected{Token: "c"}), ""
+	}
+	return peg.Success(here + 1), "c"
+}
+
+func (session *Session) m_Farthest_alt1_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt1_contents[here]; ok {
+		return result, session.whatm_Farthest_alt1_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt1_contents[key]; ok {
+			return result, session.whatm_Farthest_alt1_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt1_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt1_contents[key] = result
+	session.whatm_Farthest_alt1_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "2" ("a" / "b" / regex "[0-9]") }
+func (session *Session) dm_Farthest_alt1_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt1_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt1_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt1_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt1_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt1_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt1_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt1_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt1_contents_seq[key] = result
+	session.whatm_Farthest_alt1_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "2" ("a" / "b" / regex "[0-9]")
+func (session *Session) dm_Farthest_alt1_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt1_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt1_contents_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt1_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt1_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt1_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt1_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt1_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt1_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt1_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt1_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "2"
+func (session *Session) dm_Farthest_alt1_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "2" {
+		return session.failures.Fail(here, peg.Expected{Token: "2"}), ""
+	}
+	return peg.Success(here + 1), "2"
+}
+
+func (session *Session) m_Farthest_alt1_contents_seq1_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt1_contents_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt1_contents_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt1_contents_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt1_contents_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt1_contents_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt1_contents_seq1_alt[key] = result
+	session.whatm_Farthest_alt1_contents_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a" / "b" / regex "[0-9]")
+func (session *Session) dm_Farthest_alt1_contents_seq1_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt2_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents[key] = result
+	session.whatm_Farthest_alt2_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "3" (contents { "a" "b" } / contents { "a" "c" "d" } / "e") }
+func (session *Session) dm_Farthest_alt2_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt2_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq[key] = result
+	session.whatm_Farthest_alt2_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "3" (contents { "a" "b" } / contents { "a" "c" "d" } / "e")
+func (session *Session) dm_Farthest_alt2_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt2_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt2_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "3"
+func (session *Session) dm_Farthest_alt2_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "3" {
+		return session.failures.Fail(here, peg.Expected{Token: "3"}), ""
+	}
+	return peg.Success(here + 1), "3"
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (contents { "a" "b" } / contents { "a" "c" "d" } / "e")
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt0_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt0_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt0_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt0_contents[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt0_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "a" "b" }
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt0_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "b"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt1_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt1_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt1_contents[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt1_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "a" "c" "d" }
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt1_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt1_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt1_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "c" "d"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt1_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "d"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "d" {
+		return session.failures.Fail(here, peg.Expected{Token: "d"}), ""
+	}
+	return peg.Success(here + 1), "d"
+}
+
+func (session *Session) m_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt2_lit[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "e"
+func (session *Session) dm_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "e" {
+		return session.failures.Fail(here, peg.Expected{Token: "e"}), ""
+	}
+	return peg.Success(here + 1), "e"
+}
+
+func (session *Session) m_Farthest_alt3_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt3_contents[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents[key] = result
+	session.whatm_Farthest_alt3_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "4" ("a" / "b") (contents { "c" "d" } / "e") }
+func (session *Session) dm_Farthest_alt3_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt3_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq[key] = result
+	session.whatm_Farthest_alt3_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "4" ("a" / "b") (contents { "c" "d" } / "e")
+func (session *Session) dm_Farthest_alt3_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt3_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt3_contents_seq1_seq(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt3_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "4"
+func (session *Session) dm_Farthest_alt3_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "4" {
+		return session.failures.Fail(here, peg.Expected{Token: "4"}), ""
+	}
+	return peg.Success(here + 1), "4"
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt3_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a" / "b") (contents { "c" "d" } / "e")
+func (session *Session) dm_Farthest_alt3_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt3_contents_seq1_seq0_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt3_contents_seq1_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq1_seq0_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq0_alt[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq1_seq0_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq0_alt[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq1_seq0_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq1_seq0_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq1_seq0_alt[key] = result
+	session.whatm_Farthest_alt3_contents_seq1_seq0_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a" / "b")
+func (session *Session) dm_Farthest_alt3_contents_seq1_seq0_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq1_seq1_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq1_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq1_seq1_alt[key] = result
+	session.whatm_Farthest_alt3_contents_seq1_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (contents { "c" "d" } / "e")
+func (session *Session) dm_Farthest_alt3_contents_seq1_seq1_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt3_contents_seq1_seq1_alt0_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq1_seq1_alt0_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq1_seq1_alt0_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents[key] = result
+	session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "c" "d" }
+func (session *Session) dm_Farthest_alt3_contents_seq1_seq1_alt0_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[key] = result
+	session.whatm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "c" "d"
+func (session *Session) dm_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt4_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt4_contents[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents[key] = result
+	session.whatm_Farthest_alt4_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "5" ("a" ("b" / "c") / "a" "d") }
+func (session *Session) dm_Farthest_alt4_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt4_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq[key] = result
+	session.whatm_Farthest_alt4_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "5" ("a" ("b" / "c") / "a" "d")
+func (session *Session) dm_Farthest_alt4_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt4_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt4_contents_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt4_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "5"
+func (session *Session) dm_Farthest_alt4_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "5" {
+		return session.failures.Fail(here, peg.Expected{Token: "5"}), ""
+	}
+	return peg.Success(here + 1), "5"
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq1_alt(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq1_alt[key] = result
+	session.whatm_Farthest_alt4_contents_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a" ("b" / "c") / "a" "d")
+func (session *Session) dm_Farthest_alt4_contents_seq1_alt(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt4_contents_seq1_alt0_seq(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt4_contents_seq1_alt1_seq(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero struct {
+		V0 string
+		V1 string
+	}
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq1_alt0_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt0_seq[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq1_alt0_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt0_seq[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq1_alt0_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq1_alt0_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq1_alt0_seq[key] = result
+	session.whatm_Farthest_alt4_contents_seq1_alt0_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" ("b" / "c")
+func (session *Session) dm_Farthest_alt4_contents_seq1_alt0_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt4_contents_seq1_alt0_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq1_alt0_seq1_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq1_alt0_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq1_alt0_seq1_alt[key] = result
+	session.whatm_Farthest_alt4_contents_seq1_alt0_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("b" / "c")
+func (session *Session) dm_Farthest_alt4_contents_seq1_alt0_seq1_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt4_contents_seq1_alt1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt4_contents_seq1_alt1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt4_contents_seq1_alt1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt4_contents_seq1_alt1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt4_contents_seq1_alt1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt4_contents_seq1_alt1_seq[key] = result
+	session.whatm_Farthest_alt4_contents_seq1_alt1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "d"
+func (session *Session) dm_Farthest_alt4_contents_seq1_alt1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt5_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt5_contents[here]; ok {
+		return result, session.whatm_Farthest_alt5_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt5_contents[key]; ok {
+			return result, session.whatm_Farthest_alt5_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt5_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt5_contents[key] = result
+	session.whatm_Farthest_alt5_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "6" ("a")* "b" }
+func (session *Session) dm_Farthest_alt5_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt5_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt5_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 []string
+		V1 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt5_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt5_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt5_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt5_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt5_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt5_contents_seq[key] = result
+	session.whatm_Farthest_alt5_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "6" ("a")* "b"
+func (session *Session) dm_Farthest_alt5_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 []string
+		V1 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 []string
+			V1 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt5_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 []string
+				V1 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt5_contents_seq1_seq(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 []string
+				V1 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt5_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt5_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt5_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt5_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt5_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt5_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt5_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt5_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "6"
+func (session *Session) dm_Farthest_alt5_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "6" {
+		return session.failures.Fail(here, peg.Expected{Token: "6"}), ""
+	}
+	return peg.Success(here + 1), "6"
+}
+
+func (session *Session) m_Farthest_alt5_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 []string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt5_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt5_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt5_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt5_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt5_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt5_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt5_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a")* "b"
+func (session *Session) dm_Farthest_alt5_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 []string
+	V1 string
+}) {
+	result := struct {
+		V0 []string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt5_contents_seq1_seq0_star(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 []string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt5_contents_seq1_seq0_star(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Farthest_alt5_contents_seq1_seq0_star[here]; ok {
+		return result, session.whatm_Farthest_alt5_contents_seq1_seq0_star[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt5_contents_seq1_seq0_star[key]; ok {
+			return result, session.whatm_Farthest_alt5_contents_seq1_seq0_star[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt5_contents_seq1_seq0_star(here)
+	session.depth--
+	session.wherem_Farthest_alt5_contents_seq1_seq0_star[key] = result
+	session.whatm_Farthest_alt5_contents_seq1_seq0_star[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a")*
+func (session *Session) dm_Farthest_alt5_contents_seq1_seq0_star(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_Letters_star_alt1_lit(here)
+		if next.Fatal {
+			return next, nil
+		}
+		if !next.Ok {
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_Farthest_alt6_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt6_contents[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents[key] = result
+	session.whatm_Farthest_alt6_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "7" (contents { "a" "b" "c" } / "a") "z" }
+func (session *Session) dm_Farthest_alt6_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt6_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq[key] = result
+	session.whatm_Farthest_alt6_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "7" (contents { "a" "b" "c" } / "a") "z"
+func (session *Session) dm_Farthest_alt6_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 string
+		V1 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 string
+			V1 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt6_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt6_contents_seq1_seq(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt6_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "7"
+func (session *Session) dm_Farthest_alt6_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "7" {
+		return session.failures.Fail(here, peg.Expected{Token: "7"}), ""
+	}
+	return peg.Success(here + 1), "7"
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt6_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (contents { "a" "b" "c" } / "a") "z"
+func (session *Session) dm_Farthest_alt6_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt6_contents_seq1_seq0_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt6_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq1_seq0_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq0_alt[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq1_seq0_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq0_alt[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq1_seq0_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq1_seq0_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq1_seq0_alt[key] = result
+	session.whatm_Farthest_alt6_contents_seq1_seq0_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (contents { "a" "b" "c" } / "a")
+func (session *Session) dm_Farthest_alt6_contents_seq1_seq0_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Farthest_alt6_contents_seq1_seq0_alt0_contents(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq1_seq0_alt0_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq1_seq0_alt0_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq1_seq0_alt0_contents[key] = result
+	session.whatm_Farthest_alt6_contents_seq1_seq0_alt0_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "a" "b" "c" }
+func (session *Session) dm_Farthest_alt6_contents_seq1_seq0_alt0_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt0_contents_seq1_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt6_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq1_lit[here]; ok {
+		return result, session.whatm_Farthest_alt6_contents_seq1_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt6_contents_seq1_seq1_lit[key]; ok {
+			return result, session.whatm_Farthest_alt6_contents_seq1_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt6_contents_seq1_seq1_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt6_contents_seq1_seq1_lit[key] = result
+	session.whatm_Farthest_alt6_contents_seq1_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "z"
+func (session *Session) dm_Farthest_alt6_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "z" {
+		return session.failures.Fail(here, peg.Expected{Token: "z"}), ""
+	}
+	return peg.Success(here + 1), "z"
+}
+
+func (session *Session) m_Farthest_alt7_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt7_contents[here]; ok {
+		return result, session.whatm_Farthest_alt7_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt7_contents[key]; ok {
+			return result, session.whatm_Farthest_alt7_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt7_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt7_contents[key] = result
+	session.whatm_Farthest_alt7_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "8" not ("a" "b") "a" "c" }
+func (session *Session) dm_Farthest_alt7_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt7_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt7_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 struct{}
+		V1 string
+		V2 string
+	}
+}) {
+	if result, ok := session.wherem_Farthest_alt7_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt7_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt7_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt7_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt7_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt7_contents_seq[key] = result
+	session.whatm_Farthest_alt7_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "8" not ("a" "b") "a" "c"
+func (session *Session) dm_Farthest_alt7_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct {
+		V0 struct{}
+		V1 string
+		V2 string
+	}
+}) {
+	result := struct {
+		V0 string
+		V1 struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt7_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 struct{}
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	if next, value := session.m_Farthest_alt7_contents_seq1_seq(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct {
+				V0 struct{}
+				V1 string
+				V2 string
+			}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt7_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt7_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt7_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt7_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt7_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt7_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt7_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt7_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "8"
+func (session *Session) dm_Farthest_alt7_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "8" {
+		return session.failures.Fail(here, peg.Expected{Token: "8"}), ""
+	}
+	return peg.Success(here + 1), "8"
+}
+
+func (session *Session) m_Farthest_alt7_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Farthest_alt7_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt7_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt7_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt7_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt7_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt7_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt7_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not ("a" "b") "a" "c"
+func (session *Session) dm_Farthest_alt7_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 struct{}
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt7_contents_seq1_seq0_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt7_contents_seq1_seq0_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_Farthest_alt7_contents_seq1_seq0_not[here]; ok {
+		return result, session.whatm_Farthest_alt7_contents_seq1_seq0_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt7_contents_seq1_seq0_not[key]; ok {
+			return result, session.whatm_Farthest_alt7_contents_seq1_seq0_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt7_contents_seq1_seq0_not(here)
+	session.depth--
+	session.wherem_Farthest_alt7_contents_seq1_seq0_not[key] = result
+	session.whatm_Farthest_alt7_contents_seq1_seq0_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not ("a" "b")
+func (session *Session) dm_Farthest_alt7_contents_seq1_seq0_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "\"a\" then \"b\""}), struct{}{}
+}
+
+func (session *Session) m_Farthest_alt8_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt8_contents[here]; ok {
+		return result, session.whatm_Farthest_alt8_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt8_contents[key]; ok {
+			return result, session.whatm_Farthest_alt8_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt8_contents(here)
+	session.depth--
+	session.wherem_Farthest_alt8_contents[key] = result
+	session.whatm_Farthest_alt8_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { "9" ("a" / "ab") }
+func (session *Session) dm_Farthest_alt8_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_Farthest_alt8_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_Farthest_alt8_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt8_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt8_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt8_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt8_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt8_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt8_contents_seq[key] = result
+	session.whatm_Farthest_alt8_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "9" ("a" / "ab")
+func (session *Session) dm_Farthest_alt8_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Farthest_alt8_contents_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt8_contents_seq1_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Farthest_alt8_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt8_contents_seq0_lit[here]; ok {
+		return result, session.whatm_Farthest_alt8_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt8_contents_seq0_lit[key]; ok {
+			return result, session.whatm_Farthest_alt8_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt8_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt8_contents_seq0_lit[key] = result
+	session.whatm_Farthest_alt8_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "9"
+func (session *Session) dm_Farthest_alt8_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "9" {
+		return session.failures.Fail(here, peg.Expected{Token: "9"}), ""
+	}
+	return peg.Success(here + 1), "9"
+}
+
+func (session *Session) m_Farthest_alt8_contents_seq1_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt8_contents_seq1_alt[here]; ok {
+		return result, session.whatm_Farthest_alt8_contents_seq1_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt8_contents_seq1_alt[key]; ok {
+			return result, session.whatm_Farthest_alt8_contents_seq1_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt8_contents_seq1_alt(here)
+	session.depth--
+	session.wherem_Farthest_alt8_contents_seq1_alt[key] = result
+	session.whatm_Farthest_alt8_contents_seq1_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("a" / "ab")
+func (session *Session) dm_Farthest_alt8_contents_seq1_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Farthest_alt8_contents_seq1_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Farthest_alt8_contents_seq1_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt8_contents_seq1_alt1_lit[here]; ok {
+		return result, session.whatm_Farthest_alt8_contents_seq1_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt8_contents_seq1_alt1_lit[key]; ok {
+			return result, session.whatm_Farthest_alt8_contents_seq1_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt8_contents_seq1_alt1_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt8_contents_seq1_alt1_lit[key] = result
+	session.whatm_Farthest_alt8_contents_seq1_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "ab"
+func (session *Session) dm_Farthest_alt8_contents_seq1_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+2) || string(session.slice(here, here+2)) != "ab" {
+		return session.failures.Fail(here, peg.Expected{Token: "ab"}), ""
+	}
+	return peg.Success(here + 2), "ab"
+}
+
+func (session *Session) m_Items(here int) (peg.Result, []string) {
+	session.enter(here)
+	result, value := session.dm_Items(here)
+	session.depth--
+	return result, value
+}
+
+// root Items
+func (session *Session) dm_Items(here int) (peg.Result, []string) {
+	return session.m_Items_star(here)
+}
+
+func (session *Session) m_Items_star(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Items_star[here]; ok {
+		return result, session.whatm_Items_star[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Items_star[key]; ok {
+			return result, session.whatm_Items_star[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items_star(here)
+	session.depth--
+	session.wherem_Items_star[key] = result
+	session.whatm_Items_star[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (recover (root name root space ";" go string { arg.V0 }) until (";"))*
+func (session *Session) dm_Items_star(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_Items_star_recover(here)
+		if next.Fatal {
+			return next, nil
+		}
+		if !next.Ok {
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_Items_star_recover(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Items_star_recover[here]; ok {
+		return result, session.whatm_Items_star_recover[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Items_star_recover[key]; ok {
+			return result, session.whatm_Items_star_recover[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items_star_recover(here)
+	session.depth--
+	session.wherem_Items_star_recover[key] = result
+	session.whatm_Items_star_recover[key] = value
+	session.count(here)
+	return result, value
+}
+
+// recover (root name root space ";" go string { arg.V0 }) until (";")
+func (session *Session) dm_Items_star_recover(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_Items_star_recover0_go(here)
+	if check.Ok || session.failures.Silent != 0 {
+		return check, value
+	}
+	recovered := session.failure(check)
+	session.failures.Clear()
+	at := here
+	for session.available(here, at+1) {
+		session.failures.Silent++
+		sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+		session.failures.Silent--
+		if sync.Ok {
+			break
+		}
+		at = session.next(at)
+	}
+	var placeholder string = "?"
+	return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+}
+
+func (session *Session) m_Items_star_recover0_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Items_star_recover0_go[here]; ok {
+		return result, session.whatm_Items_star_recover0_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Items_star_recover0_go[key]; ok {
+			return result, session.whatm_Items_star_recover0_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items_star_recover0_go(here)
+	session.depth--
+	session.wherem_Items_star_recover0_go[key] = result
+	session.whatm_Items_star_recover0_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root name root space ";" go string { arg.V0 }
+func (session *Session) dm_Items_star_recover0_go(here int) (peg.Result, string) {
+	check, value := session.m_Items_star_recover0_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 string
+	}) string {
+		return arg.V0
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_Items_star_recover0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Items_star_recover0_go_seq[here]; ok {
+		return result, session.whatm_Items_star_recover0_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Items_star_recover0_go_seq[key]; ok {
+			return result, session.whatm_Items_star_recover0_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items_star_recover0_go_seq(here)
+	session.depth--
+	session.wherem_Items_star_recover0_go_seq[key] = result
+	session.whatm_Items_star_recover0_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root name root space ";"
+func (session *Session) dm_Items_star_recover0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_name(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Letters(here int) (peg.Result, []string) {
+	session.enter(here)
+	result, value := session.dm_Letters(here)
+	session.depth--
+	return result, value
+}
+
+// root Letters
+func (session *Session) dm_Letters(here int) (peg.Result, []string) {
+	return session.m_Letters_star(here)
+}
+
+func (session *Session) m_Letters_star(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Letters_star[here]; ok {
+		return result, session.whatm_Letters_star[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters_star[key]; ok {
+			return result, session.whatm_Letters_star[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star(here)
+	session.depth--
+	session.wherem_Letters_star[key] = result
+	session.whatm_Letters_star[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ((regex "b" / "a"))*
+func (session *Session) dm_Letters_star(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_Letters_star_alt(here)
+		if next.Fatal {
+			return next, nil
+		}
+		if !next.Ok {
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_Letters_star_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Letters_star_alt[here]; ok {
+		return result, session.whatm_Letters_star_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters_star_alt[key]; ok {
+			return result, session.whatm_Letters_star_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star_alt(here)
+	session.depth--
+	session.wherem_Letters_star_alt[key] = result
+	session.whatm_Letters_star_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (regex "b" / "a")
+func (session *Session) dm_Letters_star_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Letters_star_alt0_regex(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Letters_star_alt0_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Letters_star_alt0_regex[here]; ok {
+		return result, session.whatm_Letters_star_alt0_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters_star_alt0_regex[key]; ok {
+			return result, session.whatm_Letters_star_alt0_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star_alt0_regex(here)
+	session.depth--
+	session.wherem_Letters_star_alt0_regex[key] = result
+	session.whatm_Letters_star_alt0_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "b"
+func (session *Session) dm_Letters_star_alt0_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_Letters_star_alt0_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_Letters_star_alt0_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "b"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Letters_star_alt1_lit[here]; ok {
+		return result, session.whatm_Letters_star_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters_star_alt1_lit[key]; ok {
+			return result, session.whatm_Letters_star_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star_alt1_lit(here)
+	session.depth--
+	session.wherem_Letters_star_alt1_lit[key] = result
+	session.whatm_Letters_star_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a"
+func (session *Session) dm_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "a" {
+		return session.failures.Fail(here, peg.Expected{Token: "a"}), ""
+	}
+	return peg.Success(here + 1), "a"
+}
+
+func (session *Session) m_Nest(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_Nest(here)
+	session.depth--
+	return result, value
+}
+
+// root Nest
+func (session *Session) dm_Nest(here int) (peg.Result, string) {
+	return session.m_Nest_go(here)
+}
+
+func (session *Session) m_Nest_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Nest_go[here]; ok {
+		return result, session.whatm_Nest_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Nest_go[key]; ok {
+			return result, session.whatm_Nest_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest_go(here)
+	session.depth--
+	session.wherem_Nest_go[key] = result
+	session.whatm_Nest_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (root nest "!") root nest go string { arg.V1 }
+func (session *Session) dm_Nest_go(here int) (peg.Result, string) {
+	check, value := session.m_Nest_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 struct{}
+		V1 string
+	}) string {
+		return arg.V1
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_Nest_go_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 string
+}) {
+	if result, ok := session.wherem_Nest_go_seq[here]; ok {
+		return result, session.whatm_Nest_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Nest_go_seq[key]; ok {
+			return result, session.whatm_Nest_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest_go_seq(here)
+	session.depth--
+	session.wherem_Nest_go_seq[key] = result
+	session.whatm_Nest_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (root nest "!") root nest
+func (session *Session) dm_Nest_go_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 string
+}) {
+	result := struct {
+		V0 struct{}
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Nest_go_seq0_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 string
+		}{}
+	}
+	if next, value := session.m_nest(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Nest_go_seq0_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_Nest_go_seq0_not[here]; ok {
+		return result, session.whatm_Nest_go_seq0_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Nest_go_seq0_not[key]; ok {
+			return result, session.whatm_Nest_go_seq0_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest_go_seq0_not(here)
+	session.depth--
+	session.wherem_Nest_go_seq0_not[key] = result
+	session.whatm_Nest_go_seq0_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (root nest "!")
+func (session *Session) dm_Nest_go_seq0_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := session.m_Nest_go_seq0_not_seq(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "nest then \"!\""}), struct{}{}
+}
+
+func (session *Session) m_Nest_go_seq0_not_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Nest_go_seq0_not_seq[here]; ok {
+		return result, session.whatm_Nest_go_seq0_not_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Nest_go_seq0_not_seq[key]; ok {
+			return result, session.whatm_Nest_go_seq0_not_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest_go_seq0_not_seq(here)
+	session.depth--
+	session.wherem_Nest_go_seq0_not_seq[key] = result
+	session.whatm_Nest_go_seq0_not_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root nest "!"
+func (session *Session) dm_Nest_go_seq0_not_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_nest(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Number(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_Number(here)
+	session.depth--
+	return result, value
+}
+
+// root Number
+func (session *Session) dm_Number(here int) (peg.Result, string) {
+	return session.m_number(here)
+}
+
+func (session *Session) m_Word(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_Word(here)
+	session.depth--
+	return result, value
+}
+
+// root Word
+func (session *Session) dm_Word(here int) (peg.Result, string) {
+	return session.m_Word_alt(here)
+}
+
+func (session *Session) m_Word_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt[here]; ok {
+		return result, session.whatm_Word_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt[key]; ok {
+			return result, session.whatm_Word_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt(here)
+	session.depth--
+	session.wherem_Word_alt[key] = result
+	session.whatm_Word_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (not ("if" ~ "(") &(regex "[a-z]" ~ regex "[a-z]") regex "[a-z]+" go string { arg.V2 } / "x")
+func (session *Session) dm_Word_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_Word_alt0_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_Word_alt0_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt0_go[here]; ok {
+		return result, session.whatm_Word_alt0_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go[key]; ok {
+			return result, session.whatm_Word_alt0_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go(here)
+	session.depth--
+	session.wherem_Word_alt0_go[key] = result
+	session.whatm_Word_alt0_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not ("if" ~ "(") &(regex "[a-z]" ~ regex "[a-z]") regex "[a-z]+" go string { arg.V2 }
+func (session *Session) dm_Word_alt0_go(here int) (peg.Result, string) {
+	check, value := session.m_Word_alt0_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 struct{}
+		V1 struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		V2 string
+	}) string {
+		return arg.V2
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_Word_alt0_go_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	V2 string
+}) {
+	if result, ok := session.wherem_Word_alt0_go_seq[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq[key] = result
+	session.whatm_Word_alt0_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not ("if" ~ "(") &(regex "[a-z]" ~ regex "[a-z]") regex "[a-z]+"
+func (session *Session) dm_Word_alt0_go_seq(here int) (peg.Result, struct {
+	V0 struct{}
+	V1 struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}
+	V2 string
+}) {
+	result := struct {
+		V0 struct{}
+		V1 struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Word_alt0_go_seq0_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Word_alt0_go_seq1_and(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Word_alt0_go_seq2_regex(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Word_alt0_go_seq0_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_Word_alt0_go_seq0_not[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq0_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq0_not[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq0_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq0_not(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq0_not[key] = result
+	session.whatm_Word_alt0_go_seq0_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not ("if" ~ "(")
+func (session *Session) dm_Word_alt0_go_seq0_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := session.m_Word_alt0_go_seq0_not_seq(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "\"if\" then \"(\""}), struct{}{}
+}
+
+func (session *Session) m_Word_alt0_go_seq0_not_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	if result, ok := session.wherem_Word_alt0_go_seq0_not_seq[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq0_not_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq0_not_seq[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq0_not_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq0_not_seq(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq0_not_seq[key] = result
+	session.whatm_Word_alt0_go_seq0_not_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "if" ~ "("
+func (session *Session) dm_Word_alt0_go_seq0_not_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Word_alt0_go_seq0_not_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Word_alt0_go_seq0_not_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt0_go_seq0_not_seq0_lit[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq0_not_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq0_not_seq0_lit[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq0_not_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq0_not_seq0_lit(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq0_not_seq0_lit[key] = result
+	session.whatm_Word_alt0_go_seq0_not_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "if"
+func (session *Session) dm_Word_alt0_go_seq0_not_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+2) || string(session.slice(here, here+2)) != "if" {
+		return session.failures.Fail(here, peg.Expected{Token: "if"}), ""
+	}
+	return peg.Success(here + 2), "if"
+}
+
+func (session *Session) m_Word_alt0_go_seq1_and(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	if result, ok := session.wherem_Word_alt0_go_seq1_and[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq1_and[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq1_and[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq1_and[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq1_and(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq1_and[key] = result
+	session.whatm_Word_alt0_go_seq1_and[key] = value
+	session.count(here)
+	return result, value
+}
+
+// &(regex "[a-z]" ~ regex "[a-z]")
+func (session *Session) dm_Word_alt0_go_seq1_and(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_Word_alt0_go_seq1_and_seq(here)
+	if !check.Ok {
+		check.Fatal = false
+		var zero struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		return check, zero
+	}
+	return peg.Success(here), value
+}
+
+func (session *Session) m_Word_alt0_go_seq1_and_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	if result, ok := session.wherem_Word_alt0_go_seq1_and_seq[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq1_and_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq1_and_seq[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq1_and_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq1_and_seq(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq1_and_seq[key] = result
+	session.whatm_Word_alt0_go_seq1_and_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z]" ~ regex "[a-z]"
+func (session *Session) dm_Word_alt0_go_seq1_and_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt0_go_seq1_and_seq0_regex[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq1_and_seq0_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq1_and_seq0_regex[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq1_and_seq0_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq1_and_seq0_regex(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq1_and_seq0_regex[key] = result
+	session.whatm_Word_alt0_go_seq1_and_seq0_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z]"
+func (session *Session) dm_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_Word_alt0_go_seq2_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt0_go_seq2_regex[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq2_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq2_regex[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq2_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq2_regex(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq2_regex[key] = result
+	session.whatm_Word_alt0_go_seq2_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z]+"
+func (session *Session) dm_Word_alt0_go_seq2_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_Word_alt0_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_Word_alt0_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]+"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_Word_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt1_lit[here]; ok {
+		return result, session.whatm_Word_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt1_lit[key]; ok {
+			return result, session.whatm_Word_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt1_lit(here)
+	session.depth--
+	session.wherem_Word_alt1_lit[key] = result
+	session.whatm_Word_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "x"
+func (session *Session) dm_Word_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "x" {
+		return session.failures.Fail(here, peg.Expected{Token: "x"}), ""
+	}
+	return peg.Success(here + 1), "x"
+}
+
+func (session *Session) m_keyword(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_keyword(here)
+	session.depth--
+	return result, value
+}
+
+// root keyword
+func (session *Session) dm_keyword(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := session.m_keyword_go_seq(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 struct{}
+		}) string {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_keyword_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+}) {
+	if result, ok := session.wherem_keyword_go_seq[here]; ok {
+		return result, session.whatm_keyword_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq[key]; ok {
+			return result, session.whatm_keyword_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq(here)
+	session.depth--
+	session.wherem_keyword_go_seq[key] = result
+	session.whatm_keyword_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("let" / "print") not (regex "[a-z0-9]")
+func (session *Session) dm_keyword_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+}) {
+	result := struct {
+		V0 string
+		V1 struct{}
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_keyword_go_seq0_alt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+		}{}
+	}
+	if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_keyword_go_seq0_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq0_alt[here]; ok {
+		return result, session.whatm_keyword_go_seq0_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq0_alt[key]; ok {
+			return result, session.whatm_keyword_go_seq0_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt(here)
+	session.depth--
+	session.wherem_keyword_go_seq0_alt[key] = result
+	session.whatm_keyword_go_seq0_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("let" / "print")
+func (session *Session) dm_keyword_go_seq0_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq0_alt0_lit[here]; ok {
+		return result, session.whatm_keyword_go_seq0_alt0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq0_alt0_lit[key]; ok {
+			return result, session.whatm_keyword_go_seq0_alt0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt0_lit(here)
+	session.depth--
+	session.wherem_keyword_go_seq0_alt0_lit[key] = result
+	session.whatm_keyword_go_seq0_alt0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "let"
+func (session *Session) dm_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+3) || string(session.slice(here, here+3)) != "let" {
+		return session.failures.Fail(here, peg.Expected{Token: "let"}), ""
+	}
+	return peg.Success(here + 3), "let"
+}
+
+func (session *Session) m_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq0_alt1_lit[here]; ok {
+		return result, session.whatm_keyword_go_seq0_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq0_alt1_lit[key]; ok {
+			return result, session.whatm_keyword_go_seq0_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt1_lit(here)
+	session.depth--
+	session.wherem_keyword_go_seq0_alt1_lit[key] = result
+	session.whatm_keyword_go_seq0_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "print"
+func (session *Session) dm_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+5) || string(session.slice(here, here+5)) != "print" {
+		return session.failures.Fail(here, peg.Expected{Token: "print"}), ""
+	}
+	return peg.Success(here + 5), "print"
+}
+
+func (session *Session) m_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_keyword_go_seq1_not[here]; ok {
+		return result, session.whatm_keyword_go_seq1_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq1_not[key]; ok {
+			return result, session.whatm_keyword_go_seq1_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq1_not(here)
+	session.depth--
+	session.wherem_keyword_go_seq1_not[key] = result
+	session.whatm_keyword_go_seq1_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (regex "[a-z0-9]")
+func (session *Session) dm_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := session.m_keyword_go_seq1_not_regex(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "text matching `[a-z0-9]`"}), struct{}{}
+}
+
+func (session *Session) m_keyword_go_seq1_not_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq1_not_regex[here]; ok {
+		return result, session.whatm_keyword_go_seq1_not_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq1_not_regex[key]; ok {
+			return result, session.whatm_keyword_go_seq1_not_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq1_not_regex(here)
+	session.depth--
+	session.wherem_keyword_go_seq1_not_regex[key] = result
+	session.whatm_keyword_go_seq1_not_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z0-9]"
+func (session *Session) dm_keyword_go_seq1_not_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_keyword_go_seq1_not_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_keyword_go_seq1_not_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z0-9]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_name(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_name(here)
+	session.depth--
+	return result, value
+}
+
+// root name
+func (session *Session) dm_name(here int) (peg.Result, string) {
+	return session.m_name_alias(here)
+}
+
+func (session *Session) m_name_alias(here int) (peg.Result, string) {
+	if result, ok := session.wherem_name_alias[here]; ok {
+		return result, session.whatm_name_alias[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name_alias[key]; ok {
+			return result, session.whatm_name_alias[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name_alias(here)
+	session.depth--
+	session.wherem_name_alias[key] = result
+	session.whatm_name_alias[key] = value
+	session.count(here)
+	return result, value
+}
+
+// alias "name" (root space not (root keyword) regex "[a-z][a-z0-9]*" go string { arg.V2 })
+func (session *Session) dm_name_alias(here int) (peg.Result, string) {
+	mark := session.failures.Mark()
+	check, value := session.m_name_alias_go(here)
+	if !check.Ok {
+		return session.failures.Name(mark, session.named(here, check.At), check, "name"), value
+	}
+	return check, value
+}
+
+func (session *Session) m_name_alias_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_name_alias_go[here]; ok {
+		return result, session.whatm_name_alias_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name_alias_go[key]; ok {
+			return result, session.whatm_name_alias_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name_alias_go(here)
+	session.depth--
+	session.wherem_name_alias_go[key] = result
+	session.whatm_name_alias_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space not (root keyword) regex "[a-z][a-z0-9]*" go string { arg.V2 }
+func (session *Session) dm_name_alias_go(here int) (peg.Result, string) {
+	check, value := session.m_name_alias_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}) string {
+		return arg.V2
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_name_alias_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	if result, ok := session.wherem_name_alias_go_seq[here]; ok {
+		return result, session.whatm_name_alias_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name_alias_go_seq[key]; ok {
+			return result, session.whatm_name_alias_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name_alias_go_seq(here)
+	session.depth--
+	session.wherem_name_alias_go_seq[key] = result
+	session.whatm_name_alias_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space not (root keyword) regex "[a-z][a-z0-9]*"
+func (session *Session) dm_name_alias_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 struct{}
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 struct{}
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_name_alias_go_seq1_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	if next, value := session.m_name_alias_go_seq2_regex(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_name_alias_go_seq1_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_name_alias_go_seq1_not[here]; ok {
+		return result, session.whatm_name_alias_go_seq1_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name_alias_go_seq1_not[key]; ok {
+			return result, session.whatm_name_alias_go_seq1_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name_alias_go_seq1_not(here)
+	session.depth--
+	session.wherem_name_alias_go_seq1_not[key] = result
+	session.whatm_name_alias_go_seq1_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (root keyword)
+func (session *Session) dm_name_alias_go_seq1_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := session.m_keyword(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "keyword"}), struct{}{}
+}
+
+func (session *Session) m_name_alias_go_seq2_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_name_alias_go_seq2_regex[here]; ok {
+		return result, session.whatm_name_alias_go_seq2_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name_alias_go_seq2_regex[key]; ok {
+			return result, session.whatm_name_alias_go_seq2_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name_alias_go_seq2_regex(here)
+	session.depth--
+	session.wherem_name_alias_go_seq2_regex[key] = result
+	session.whatm_name_alias_go_seq2_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z][a-z0-9]*"
+func (session *Session) dm_name_alias_go_seq2_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_name_alias_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_name_alias_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z][a-z0-9]*"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_nest(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_nest(here)
+	session.depth--
+	return result, value
+}
+
+// root nest
+func (session *Session) dm_nest(here int) (peg.Result, string) {
+	return session.m_nest_alt(here)
+}
+
+func (session *Session) m_nest_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_nest_alt[here]; ok {
+		return result, session.whatm_nest_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt[key]; ok {
+			return result, session.whatm_nest_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt(here)
+	session.depth--
+	session.wherem_nest_alt[key] = result
+	session.whatm_nest_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("(" root nest ")" go string { arg.V1 } / "(" root nest "]" go string { arg.V1 } / "x")
+func (session *Session) dm_nest_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_nest_alt0_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_nest_alt1_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_nest_alt0_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_nest_alt0_go[here]; ok {
+		return result, session.whatm_nest_alt0_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt0_go[key]; ok {
+			return result, session.whatm_nest_alt0_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt0_go(here)
+	session.depth--
+	session.wherem_nest_alt0_go[key] = result
+	session.whatm_nest_alt0_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "(" root nest ")" go string { arg.V1 }
+func (session *Session) dm_nest_alt0_go(here int) (peg.Result, string) {
+	check, value := session.m_nest_alt0_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 string
+	}) string {
+		return arg.V1
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_nest_alt0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_nest_alt0_go_seq[here]; ok {
+		return result, session.whatm_nest_alt0_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt0_go_seq[key]; ok {
+			return result, session.whatm_nest_alt0_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt0_go_seq(here)
+	session.depth--
+	session.wherem_nest_alt0_go_seq[key] = result
+	session.whatm_nest_alt0_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "(" root nest ")"
+func (session *Session) dm_nest_alt0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_nest(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_value_alt2_go_seq4_label_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_nest_alt1_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_nest_alt1_go[here]; ok {
+		return result, session.whatm_nest_alt1_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt1_go[key]; ok {
+			return result, session.whatm_nest_alt1_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt1_go(here)
+	session.depth--
+	session.wherem_nest_alt1_go[key] = result
+	session.whatm_nest_alt1_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "(" root nest "]" go string { arg.V1 }
+func (session *Session) dm_nest_alt1_go(here int) (peg.Result, string) {
+	check, value := session.m_nest_alt1_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 string
+	}) string {
+		return arg.V1
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_nest_alt1_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_nest_alt1_go_seq[here]; ok {
+		return result, session.whatm_nest_alt1_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt1_go_seq[key]; ok {
+			return result, session.whatm_nest_alt1_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt1_go_seq(here)
+	session.depth--
+	session.wherem_nest_alt1_go_seq[key] = result
+	session.whatm_nest_alt1_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "(" root nest "]"
+func (session *Session) dm_nest_alt1_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_nest(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_nest_alt1_go_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_nest_alt1_go_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_nest_alt1_go_seq2_lit[here]; ok {
+		return result, session.whatm_nest_alt1_go_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest_alt1_go_seq2_lit[key]; ok {
+			return result, session.whatm_nest_alt1_go_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest_alt1_go_seq2_lit(here)
+	session.depth--
+	session.wherem_nest_alt1_go_seq2_lit[key] = result
+	session.whatm_nest_alt1_go_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "]"
+func (session *Session) dm_nest_alt1_go_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "]" {
+		return session.failures.Fail(here, peg.Expected{Token: "]"}), ""
+	}
+	return peg.Success(here + 1), "]"
+}
+
+func (session *Session) m_number(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_number(here)
+	session.depth--
+	return result, value
+}
+
+// root number
+func (session *Session) dm_number(here int) (peg.Result, string) {
+	return session.m_number_go(here)
+}
+
+func (session *Session) m_number_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go[here]; ok {
+		return result, session.whatm_number_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go[key]; ok {
+			return result, session.whatm_number_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go(here)
+	session.depth--
+	session.wherem_number_go[key] = result
+	session.whatm_number_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space alias "number" (contents { &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")? } try float64 { strconv.ParseFloat(arg, 64) }) go string { strconv.FormatFloat(arg.V1, 'g', -1, 64) }
+func (session *Session) dm_number_go(here int) (peg.Result, string) {
+	check, value := session.m_number_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 float64
+	}) string {
+		return strconv.FormatFloat(arg.V1, 'g', -1, 64)
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_number_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 float64
+}) {
+	if result, ok := session.wherem_number_go_seq[here]; ok {
+		return result, session.whatm_number_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq[key]; ok {
+			return result, session.whatm_number_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq(here)
+	session.depth--
+	session.wherem_number_go_seq[key] = result
+	session.whatm_number_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space alias "number" (contents { &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")? } try float64 { strconv.ParseFloat(arg, 64) })
+func (session *Session) dm_number_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 float64
+}) {
+	result := struct {
+		V0 string
+		V1 float64
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 float64
+		}{}
+	}
+	if next, value := session.m_number_go_seq1_alias(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 float64
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_number_go_seq1_alias(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_number_go_seq1_alias[here]; ok {
+		return result, session.whatm_number_go_seq1_alias[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias[key]; ok {
+			return result, session.whatm_number_go_seq1_alias[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias[key] = result
+	session.whatm_number_go_seq1_alias[key] = value
+	session.count(here)
+	return result, value
+}
+
+// alias "number" (contents { &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")? } try float64 { strconv.ParseFloat(arg, 64) })
+func (session *Session) dm_number_go_seq1_alias(here int) (peg.Result, float64) {
+	mark := session.failures.Mark()
+	check, value := session.m_number_go_seq1_alias_try(here)
+	if !check.Ok {
+		return session.failures.Name(mark, session.named(here, check.At), check, "number"), value
+	}
+	return check, value
+}
+
+func (session *Session) m_number_go_seq1_alias_try(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_number_go_seq1_alias_try[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try[key] = result
+	session.whatm_number_go_seq1_alias_try[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")? } try float64 { strconv.ParseFloat(arg, 64) }
+func (session *Session) dm_number_go_seq1_alias_try(here int) (peg.Result, float64) {
+	mark := session.failures.Save()
+	check, value := session.m_number_go_seq1_alias_try_contents(here)
+	if !check.Ok {
+		var zero float64
+		return check, zero
+	}
+	answer, err := func(arg string) (float64, error) {
+		return strconv.ParseFloat(arg, 64)
+	}(value)
+	if err != nil {
+		return session.failures.Reject(mark, here, check.At, peg.Invalid{Err: err}), answer
+	}
+	return check, answer
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents[key] = result
+	session.whatm_number_go_seq1_alias_try_contents[key] = value
+	session.count(here)
+	return result, value
+}
+
+// contents { &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")? }
+func (session *Session) dm_number_go_seq1_alias_try_contents(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, _ := session.m_number_go_seq1_alias_try_contents_seq(here)
+	if check.Ok {
+		return check, string(session.slice(here, check.At))
+	}
+	return check, ""
+
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 []string
+	V2 *struct {
+		V0 string
+		V1 []string
+	}
+	V3 *string
+}) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// &(regex "[0-9]") (regex "[0-9]")+ ("." (regex "[0-9]")+)? (regex "e[0-9]+")?
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 []string
+	V2 *struct {
+		V0 string
+		V1 []string
+	}
+	V3 *string
+}) {
+	result := struct {
+		V0 string
+		V1 []string
+		V2 *struct {
+			V0 string
+			V1 []string
+		}
+		V3 *string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq0_and(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}{}
+	}
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}{}
+	}
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq2_opt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}{}
+	}
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq3_opt(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V3 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq0_and(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq0_and(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq0_and[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq0_and[key] = value
+	session.count(here)
+	return result, value
+}
+
+// &(regex "[0-9]")
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq0_and(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+	if !check.Ok {
+		check.Fatal = false
+		var zero string
+		return check, zero
+	}
+	return peg.Success(here), value
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[0-9]"
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[0-9]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq1_plus[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq1_plus[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq1_plus[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq1_plus[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq1_plus(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq1_plus[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq1_plus[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (regex "[0-9]")+
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+		if !next.Ok {
+			if len(result) == 0 || next.Fatal {
+				return next, nil
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here && len(result) != 0 {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq2_opt(here int) (peg.Result, *struct {
+	V0 string
+	V1 []string
+}) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq2_opt(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq2_opt[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq2_opt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ("." (regex "[0-9]")+)?
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq2_opt(here int) (peg.Result, *struct {
+	V0 string
+	V1 []string
+}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_number_go_seq1_alias_try_contents_seq2_opt_seq(here)
+	if check.Ok {
+		return check, &value
+	}
+	if check.Fatal {
+		return check, nil
+	}
+	return peg.Success(here), nil
+
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq2_opt_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 []string
+}) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq2_opt_seq(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "." (regex "[0-9]")+
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq2_opt_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 []string
+}) {
+	result := struct {
+		V0 string
+		V1 []string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+		}{}
+	}
+	if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 []string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "."
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "." {
+		return session.failures.Fail(here, peg.Expected{Token: "."}), ""
+	}
+	return peg.Success(here + 1), "."
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq3_opt(here int) (peg.Result, *string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq3_opt[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq3_opt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq3_opt[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq3_opt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq3_opt(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq3_opt[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq3_opt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (regex "e[0-9]+")?
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq3_opt(here int) (peg.Result, *string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	check, value := session.m_number_go_seq1_alias_try_contents_seq3_opt_regex(here)
+	if check.Ok {
+		return check, &value
+	}
+	if check.Fatal {
+		return check, nil
+	}
+	return peg.Success(here), nil
+
+}
+
+func (session *Session) m_number_go_seq1_alias_try_contents_seq3_opt_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq3_opt_regex(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq3_opt_regex[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq3_opt_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "e[0-9]+"
+func (session *Session) dm_number_go_seq1_alias_try_contents_seq3_opt_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "e[0-9]+"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_space(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_space(here)
+	session.depth--
+	return result, value
+}
+
+// root space
+func (session *Session) dm_space(here int) (peg.Result, string) {
+	return session.m_space_regex(here)
+}
+
+func (session *Session) m_space_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_space_regex[here]; ok {
+		return result, session.whatm_space_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_space_regex[key]; ok {
+			return result, session.whatm_space_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_space_regex(here)
+	session.depth--
+	session.wherem_space_regex[key] = result
+	session.whatm_space_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[ \\t\\r\\n]*"
+func (session *Session) dm_space_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = resourcem_space_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = resourcem_space_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[ \\t\\r\\n]*"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *Session) m_statement(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_statement(here)
+	session.depth--
+	return result, value
+}
+
+// root statement
+func (session *Session) dm_statement(here int) (peg.Result, string) {
+	return session.m_statement_alt(here)
+}
+
+func (session *Session) m_statement_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement_alt[here]; ok {
+		return result, session.whatm_statement_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt[key]; ok {
+			return result, session.whatm_statement_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt(here)
+	session.depth--
+	session.wherem_statement_alt[key] = result
+	session.whatm_statement_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (root space "let" not (regex "[a-z0-9]") ~ root name root space "="^"missing equals sign" root value go string { arg.V4 + "=" + arg.V7 } / root space "print" not (regex "[a-z0-9]") ~ (root value)+ go string { "print " + strings.Join(arg.V4, " ") })
+func (session *Session) dm_statement_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_statement_alt0_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_statement_alt1_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_statement_alt0_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement_alt0_go[here]; ok {
+		return result, session.whatm_statement_alt0_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go[key]; ok {
+			return result, session.whatm_statement_alt0_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go(here)
+	session.depth--
+	session.wherem_statement_alt0_go[key] = result
+	session.whatm_statement_alt0_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "let" not (regex "[a-z0-9]") ~ root name root space "="^"missing equals sign" root value go string { arg.V4 + "=" + arg.V7 }
+func (session *Session) dm_statement_alt0_go(here int) (peg.Result, string) {
+	check, value := session.m_statement_alt0_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 string
+		V5 string
+		V6 string
+		V7 string
+	}) string {
+		return arg.V4 + "=" + arg.V7
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_statement_alt0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 struct{}
+	V3 struct{}
+	V4 string
+	V5 string
+	V6 string
+	V7 string
+}) {
+	if result, ok := session.wherem_statement_alt0_go_seq[here]; ok {
+		return result, session.whatm_statement_alt0_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go_seq[key]; ok {
+			return result, session.whatm_statement_alt0_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq(here)
+	session.depth--
+	session.wherem_statement_alt0_go_seq[key] = result
+	session.whatm_statement_alt0_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "let" not (regex "[a-z0-9]") ~ root name root space "="^"missing equals sign" root value
+func (session *Session) dm_statement_alt0_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 struct{}
+	V3 struct{}
+	V4 string
+	V5 string
+	V6 string
+	V7 string
+}) {
+	start := here
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 string
+		V5 string
+		V6 string
+		V7 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V3 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_name(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V4 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V5 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_statement_alt0_go_seq6_label(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V6 = value
+	} else {
+		next.Fatal = true
+		next = session.failures.Open(next, start)
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	if next, value := session.m_value(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V7 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_statement_alt0_go_seq3_cut[here]; ok {
+		return result, session.whatm_statement_alt0_go_seq3_cut[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go_seq3_cut[key]; ok {
+			return result, session.whatm_statement_alt0_go_seq3_cut[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq3_cut(here)
+	session.depth--
+	session.wherem_statement_alt0_go_seq3_cut[key] = result
+	session.whatm_statement_alt0_go_seq3_cut[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ~
+func (session *Session) dm_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	return peg.Success(here), struct{}{}
+}
+
+func (session *Session) m_statement_alt0_go_seq6_label(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement_alt0_go_seq6_label[here]; ok {
+		return result, session.whatm_statement_alt0_go_seq6_label[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go_seq6_label[key]; ok {
+			return result, session.whatm_statement_alt0_go_seq6_label[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq6_label(here)
+	session.depth--
+	session.wherem_statement_alt0_go_seq6_label[key] = result
+	session.whatm_statement_alt0_go_seq6_label[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "="^"missing equals sign"
+func (session *Session) dm_statement_alt0_go_seq6_label(here int) (peg.Result, string) {
+	check, value := session.m_statement_alt0_go_seq6_label_lit(here)
+	if !check.Ok {
+		return session.failures.Label(here, "missing equals sign", false), value
+	}
+	return check, value
+}
+
+func (session *Session) m_statement_alt0_go_seq6_label_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement_alt0_go_seq6_label_lit[here]; ok {
+		return result, session.whatm_statement_alt0_go_seq6_label_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go_seq6_label_lit[key]; ok {
+			return result, session.whatm_statement_alt0_go_seq6_label_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq6_label_lit(here)
+	session.depth--
+	session.wherem_statement_alt0_go_seq6_label_lit[key] = result
+	session.whatm_statement_alt0_go_seq6_label_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "="
+func (session *Session) dm_statement_alt0_go_seq6_label_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "=" {
+		return session.failures.Fail(here, peg.Expected{Token: "="}), ""
+	}
+	return peg.Success(here + 1), "="
+}
+
+func (session *Session) m_statement_alt1_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement_alt1_go[here]; ok {
+		return result, session.whatm_statement_alt1_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt1_go[key]; ok {
+			return result, session.whatm_statement_alt1_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt1_go(here)
+	session.depth--
+	session.wherem_statement_alt1_go[key] = result
+	session.whatm_statement_alt1_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "print" not (regex "[a-z0-9]") ~ (root value)+ go string { "print " + strings.Join(arg.V4, " ") }
+func (session *Session) dm_statement_alt1_go(here int) (peg.Result, string) {
+	check, value := session.m_statement_alt1_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 []string
+	}) string {
+		return "print " + strings.Join(arg.V4, " ")
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_statement_alt1_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 struct{}
+	V3 struct{}
+	V4 []string
+}) {
+	if result, ok := session.wherem_statement_alt1_go_seq[here]; ok {
+		return result, session.whatm_statement_alt1_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt1_go_seq[key]; ok {
+			return result, session.whatm_statement_alt1_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt1_go_seq(here)
+	session.depth--
+	session.wherem_statement_alt1_go_seq[key] = result
+	session.whatm_statement_alt1_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "print" not (regex "[a-z0-9]") ~ (root value)+
+func (session *Session) dm_statement_alt1_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 struct{}
+	V3 struct{}
+	V4 []string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 struct{}
+		V3 struct{}
+		V4 []string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V3 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	if next, value := session.m_statement_alt1_go_seq4_plus(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V4 = value
+	} else {
+		next.Fatal = true
+		return next, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_statement_alt1_go_seq4_plus(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_statement_alt1_go_seq4_plus[here]; ok {
+		return result, session.whatm_statement_alt1_go_seq4_plus[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt1_go_seq4_plus[key]; ok {
+			return result, session.whatm_statement_alt1_go_seq4_plus[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt1_go_seq4_plus(here)
+	session.depth--
+	session.wherem_statement_alt1_go_seq4_plus[key] = result
+	session.whatm_statement_alt1_go_seq4_plus[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (root value)+
+func (session *Session) dm_statement_alt1_go_seq4_plus(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_value(here)
+		if !next.Ok {
+			if len(result) == 0 || next.Fatal {
+				return next, nil
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here && len(result) != 0 {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *Session) m_value(here int) (peg.Result, string) {
+	session.enter(here)
+	result, value := session.dm_value(here)
+	session.depth--
+	return result, value
+}
+
+// root value
+func (session *Session) dm_value(here int) (peg.Result, string) {
+	return session.m_value_alt(here)
+}
+
+func (session *Session) m_value_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt[here]; ok {
+		return result, session.whatm_value_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt[key]; ok {
+			return result, session.whatm_value_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt(here)
+	session.depth--
+	session.wherem_value_alt[key] = result
+	session.whatm_value_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (root number / root name / root space "(" root value root space ")"^^"unclosed parenthesis" go string { "(" + arg.V2 + ")" } / root space "!" try! string { "", errors.New("values can't be shouted") })
+func (session *Session) dm_value_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_number(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_name(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_value_alt2_go(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_value_alt3_try(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *Session) m_value_alt2_go(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go[here]; ok {
+		return result, session.whatm_value_alt2_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go[key]; ok {
+			return result, session.whatm_value_alt2_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go(here)
+	session.depth--
+	session.wherem_value_alt2_go[key] = result
+	session.whatm_value_alt2_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "(" root value root space ")"^^"unclosed parenthesis" go string { "(" + arg.V2 + ")" }
+func (session *Session) dm_value_alt2_go(here int) (peg.Result, string) {
+	check, value := session.m_value_alt2_go_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer := func(arg struct {
+		V0 string
+		V1 string
+		V2 string
+		V3 string
+		V4 string
+	}) string {
+		return "(" + arg.V2 + ")"
+	}(value)
+	return check, answer
+}
+
+func (session *Session) m_value_alt2_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+	V3 string
+	V4 string
+}) {
+	if result, ok := session.wherem_value_alt2_go_seq[here]; ok {
+		return result, session.whatm_value_alt2_go_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq[key]; ok {
+			return result, session.whatm_value_alt2_go_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq[key] = result
+	session.whatm_value_alt2_go_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "(" root value root space ")"^^"unclosed parenthesis"
+func (session *Session) dm_value_alt2_go_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+	V3 string
+	V4 string
+}) {
+	start := here
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+		V3 string
+		V4 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	if next, value := session.m_value(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V3 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	if next, value := session.m_value_alt2_go_seq4_label(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V4 = value
+	} else {
+		next = session.failures.Open(next, start)
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go_seq1_lit[here]; ok {
+		return result, session.whatm_value_alt2_go_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq1_lit[key]; ok {
+			return result, session.whatm_value_alt2_go_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq1_lit(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq1_lit[key] = result
+	session.whatm_value_alt2_go_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "("
+func (session *Session) dm_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "(" {
+		return session.failures.Fail(here, peg.Expected{Token: "("}), ""
+	}
+	return peg.Success(here + 1), "("
+}
+
+func (session *Session) m_value_alt2_go_seq4_label(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go_seq4_label[here]; ok {
+		return result, session.whatm_value_alt2_go_seq4_label[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq4_label[key]; ok {
+			return result, session.whatm_value_alt2_go_seq4_label[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq4_label(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq4_label[key] = result
+	session.whatm_value_alt2_go_seq4_label[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ")"^^"unclosed parenthesis"
+func (session *Session) dm_value_alt2_go_seq4_label(here int) (peg.Result, string) {
+	check, value := session.m_value_alt2_go_seq4_label_lit(here)
+	if !check.Ok {
+		return session.failures.Label(here, "unclosed parenthesis", true), value
+	}
+	return check, value
+}
+
+func (session *Session) m_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go_seq4_label_lit[here]; ok {
+		return result, session.whatm_value_alt2_go_seq4_label_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq4_label_lit[key]; ok {
+			return result, session.whatm_value_alt2_go_seq4_label_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq4_label_lit(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq4_label_lit[key] = result
+	session.whatm_value_alt2_go_seq4_label_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ")"
+func (session *Session) dm_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ")" {
+		return session.failures.Fail(here, peg.Expected{Token: ")"}), ""
+	}
+	return peg.Success(here + 1), ")"
+}
+
+func (session *Session) m_value_alt3_try(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt3_try[here]; ok {
+		return result, session.whatm_value_alt3_try[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt3_try[key]; ok {
+			return result, session.whatm_value_alt3_try[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt3_try(here)
+	session.depth--
+	session.wherem_value_alt3_try[key] = result
+	session.whatm_value_alt3_try[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "!" try! string { "", errors.New("values can't be shouted") }
+func (session *Session) dm_value_alt3_try(here int) (peg.Result, string) {
+	check, value := session.m_value_alt3_try_seq(here)
+	if !check.Ok {
+		var zero string
+		return check, zero
+	}
+	answer, err := func(arg struct {
+		V0 string
+		V1 string
+	}) (string, error) {
+		return "", errors.New("values can't be shouted")
+	}(value)
+	if err != nil {
+		return session.failures.Abort(here, peg.Invalid{Err: err}), answer
+	}
+	return check, answer
+}
+
+func (session *Session) m_value_alt3_try_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_value_alt3_try_seq[here]; ok {
+		return result, session.whatm_value_alt3_try_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt3_try_seq[key]; ok {
+			return result, session.whatm_value_alt3_try_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt3_try_seq(here)
+	session.depth--
+	session.wherem_value_alt3_try_seq[key] = result
+	session.whatm_value_alt3_try_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space "!"
+func (session *Session) dm_value_alt3_try_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_space(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *Session) m_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt3_try_seq1_lit[here]; ok {
+		return result, session.whatm_value_alt3_try_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt3_try_seq1_lit[key]; ok {
+			return result, session.whatm_value_alt3_try_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt3_try_seq1_lit(here)
+	session.depth--
+	session.wherem_value_alt3_try_seq1_lit[key] = result
+	session.whatm_value_alt3_try_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "!"
+func (session *Session) dm_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "!" {
+		return session.failures.Fail(here, peg.Expected{Token: "!"}), ""
+	}
+	return peg.Success(here + 1), "!"
+}
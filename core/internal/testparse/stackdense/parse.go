@@ -0,0 +1,1980 @@
+package stackdense
+
+import "context"
+import "errors"
+import "io"
+import "regexp"
+import "strconv"
+import "strings"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
+
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
+
+func NewParser() Parser {
+	return Parser{}
+}
+
+// ParseDoc parses the whole input as Doc, in a fresh session.
+func (parser Parser) ParseDoc(input []byte) ([]string, error) {
+	return parser.NewSession(input).Doc()
+}
+
+// ParseDocPrefix parses as much of the input as Doc matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseDocPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).DocPrefix()
+}
+
+// ParseDocContext parses the input as Doc, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseDocContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Doc()
+}
+
+// ParseDocReader parses the input read from the source as Doc, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseDocReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Doc()
+}
+
+// ParseFarthest parses the whole input as Farthest, in a fresh session.
+func (parser Parser) ParseFarthest(input []byte) (string, error) {
+	return parser.NewSession(input).Farthest()
+}
+
+// ParseFarthestPrefix parses as much of the input as Farthest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseFarthestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).FarthestPrefix()
+}
+
+// ParseFarthestContext parses the input as Farthest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseFarthestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Farthest()
+}
+
+// ParseFarthestReader parses the input read from the source as Farthest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseFarthestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Farthest()
+}
+
+// ParseItems parses the whole input as Items, in a fresh session.
+func (parser Parser) ParseItems(input []byte) ([]string, error) {
+	return parser.NewSession(input).Items()
+}
+
+// ParseItemsPrefix parses as much of the input as Items matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseItemsPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).ItemsPrefix()
+}
+
+// ParseItemsContext parses the input as Items, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseItemsContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Items()
+}
+
+// ParseItemsReader parses the input read from the source as Items, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseItemsReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Items()
+}
+
+// ParseLetters parses the whole input as Letters, in a fresh session.
+func (parser Parser) ParseLetters(input []byte) ([]string, error) {
+	return parser.NewSession(input).Letters()
+}
+
+// ParseLettersPrefix parses as much of the input as Letters matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseLettersPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).LettersPrefix()
+}
+
+// ParseLettersContext parses the input as Letters, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseLettersContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Letters()
+}
+
+// ParseLettersReader parses the input read from the source as Letters, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseLettersReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Letters()
+}
+
+// ParseNest parses the whole input as Nest, in a fresh session.
+func (parser Parser) ParseNest(input []byte) (string, error) {
+	return parser.NewSession(input).Nest()
+}
+
+// ParseNestPrefix parses as much of the input as Nest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NestPrefix()
+}
+
+// ParseNestContext parses the input as Nest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Nest()
+}
+
+// ParseNestReader parses the input read from the source as Nest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Nest()
+}
+
+// ParseNumber parses the whole input as Number, in a fresh session.
+func (parser Parser) ParseNumber(input []byte) (string, error) {
+	return parser.NewSession(input).Number()
+}
+
+// ParseNumberPrefix parses as much of the input as Number matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseNumberPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NumberPrefix()
+}
+
+// ParseNumberContext parses the input as Number, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseNumberContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Number()
+}
+
+// ParseNumberReader parses the input read from the source as Number, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseNumberReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Number()
+}
+
+// ParseWord parses the whole input as Word, in a fresh session.
+func (parser Parser) ParseWord(input []byte) (string, error) {
+	return parser.NewSession(input).Word()
+}
+
+// ParseWordPrefix parses as much of the input as Word matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseWordPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).WordPrefix()
+}
+
+// ParseWordContext parses the input as Word, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseWordContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Word()
+}
+
+// ParseWordReader parses the input read from the source as Word, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseWordReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Word()
+}
+
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	stack []machineFrame
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
+	window   int  // The position of the first slot in each memoization table
+	// Internal memoization tables, by node
+	memo  [][]machineEntry
+	slots [][]int32
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.memo == nil {
+		session.memo = make([][]machineEntry, len(machine))
+		session.slots = make([][]int32, len(machine))
+	}
+	for node := range machine {
+		if !machine[node].memo {
+			continue
+		}
+		session.slots[node] = session.emptySlots(session.slots[node])
+		session.memo[node] = session.memo[node][:0]
+	}
+	session.window = 0
+}
+
+func (session *Session) recall(node int, here int) (machineEntry, bool) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slots[node]) && session.slots[node][slot] != 0 {
+		return session.memo[node][session.slots[node][slot]-1], true
+	}
+	return machineEntry{}, false
+}
+
+func (session *Session) remember(node int, here int, entry machineEntry) {
+	if here < session.window {
+		return
+	}
+	session.memo[node] = append(session.memo[node], entry)
+	*session.slot(&session.slots[node], here) = int32(len(session.memo[node]))
+	session.count(here)
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	if before < session.window {
+		before = session.window
+	}
+	session.memos = 0
+	for node := range session.memo {
+		kept := session.memo[node]
+		session.memo[node] = nil
+		for slot := range session.slots[node] {
+			index := int32(0)
+			if from := slot + before - session.window; !all && from < len(session.slots[node]) && session.slots[node][from] != 0 {
+				session.memo[node] = append(session.memo[node], kept[session.slots[node][from]-1])
+				index = int32(len(session.memo[node]))
+			}
+			session.slots[node][slot] = index
+		}
+		session.memos += len(session.memo[node])
+	}
+	session.window = before
+}
+
+// emptySlots returns a memoization table with an empty slot for each position
+// of the input read so far, reusing the memory of the given one. A slot holds
+// the index of the result memoized at its position plus one, or else zero.
+func (session *Session) emptySlots(table []int32) []int32 {
+	size := len(session.input) + 1
+	if cap(table) < size {
+		return make([]int32, size)
+	}
+	table = table[:size]
+	for slot := range table {
+		table[slot] = 0
+	}
+	return table
+}
+
+// slot is the slot of the table for the given position, which must not be
+// before the window. The table grows to hold it, if the input was streamed past
+// the end of the table.
+func (session *Session) slot(table *[]int32, here int) *int32 {
+	slot := here - session.window
+	if slot >= len(*table) {
+		grown := make([]int32, 2*slot+1)
+		copy(grown, *table)
+		*table = grown
+	}
+	return &(*table)[slot]
+}
+
+// Doc parses the whole input as Doc. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Doc() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Doc(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// DocPrefix parses as much of the input as Doc matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Doc.
+func (session *Session) DocPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Doc(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Farthest parses the whole input as Farthest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Farthest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Farthest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// FarthestPrefix parses as much of the input as Farthest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Farthest.
+func (session *Session) FarthestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Farthest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Items parses the whole input as Items. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Items() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Items(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ItemsPrefix parses as much of the input as Items matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Items.
+func (session *Session) ItemsPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Items(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Letters parses the whole input as Letters. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Letters() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Letters(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// LettersPrefix parses as much of the input as Letters matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Letters.
+func (session *Session) LettersPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Letters(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Nest parses the whole input as Nest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Nest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Nest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NestPrefix parses as much of the input as Nest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Nest.
+func (session *Session) NestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Nest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Number parses the whole input as Number. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Number() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Number(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NumberPrefix parses as much of the input as Number matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Number.
+func (session *Session) NumberPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Number(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Word parses the whole input as Word. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Word() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Word(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// WordPrefix parses as much of the input as Word matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Word.
+func (session *Session) WordPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Word(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+var resourcem_Letters_star_alt0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:b)")
+var resourcem_Word_alt0_go_seq1_and_seq0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z])")
+var resourcem_Word_alt0_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z]+)")
+var resourcem_keyword_go_seq1_not_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z0-9])")
+var resourcem_name_alias_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z][a-z0-9]*)")
+var resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[0-9])")
+var resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:e[0-9]+)")
+var resourcem_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[ \\t\\r\\n]*)")
+
+// Below is the internal generated parse structure.
+// It's not very efficient right now, but is accomplishes parsing in linear time.
+
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
+}
+
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
+}
+
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
+	}
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
+	}
+	return nil
+}
+
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
+}
+
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
+}
+
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
+	}
+	return here
+}
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
+	}
+	return session.fill(here, to)
+}
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
+	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
+}
+
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
+}
+
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
+}
+
+func (session *Session) m_Doc(here int) (peg.Result, []string) {
+	check, value := session.run(0, here)
+	result, _ := value.([]string)
+	return check, result
+}
+
+func (session *Session) m_Farthest(here int) (peg.Result, string) {
+	check, value := session.run(8, here)
+	result, _ := value.(string)
+	return check, result
+}
+
+func (session *Session) m_Items(here int) (peg.Result, []string) {
+	check, value := session.run(67, here)
+	result, _ := value.([]string)
+	return check, result
+}
+
+func (session *Session) m_Letters(here int) (peg.Result, []string) {
+	check, value := session.run(72, here)
+	result, _ := value.([]string)
+	return check, result
+}
+
+func (session *Session) m_Nest(here int) (peg.Result, string) {
+	check, value := session.run(77, here)
+	result, _ := value.(string)
+	return check, result
+}
+
+func (session *Session) m_Number(here int) (peg.Result, string) {
+	check, value := session.run(82, here)
+	result, _ := value.(string)
+	return check, result
+}
+
+func (session *Session) m_Word(here int) (peg.Result, string) {
+	check, value := session.run(83, here)
+	result, _ := value.(string)
+	return check, result
+}
+
+var machine = []machineNode{
+	/* m_Doc */ {kind: machineRoot, children: []int{1}, memo: true},
+	/* m_Doc_go */ {kind: machineGo, children: []int{2}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 []string
+			V1 string
+		})
+		return func(arg struct {
+			V0 []string
+			V1 string
+		}) []string {
+			return arg.V0
+		}(arg)
+	}},
+	/* m_Doc_go_seq */ {kind: machineSequence, children: []int{3, 131}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 []string
+			V1 string
+		}
+		result.V0, _ = values[0].([]string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Doc_go_seq0_star */ {kind: machineStar, children: []int{4}, memo: false, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_Doc_go_seq0_star_go */ {kind: machineGo, children: []int{5}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+		}) string {
+			return arg.V0
+		}(arg)
+	}},
+	/* m_Doc_go_seq0_star_go_seq */ {kind: machineSequence, children: []int{6, 131, 7}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Doc_go_seq0_star_go_seq0_recover */ {kind: machineRecover, children: []int{133, 7}, memo: false, apply: func(interface{}) interface{} {
+		var placeholder string = "?"
+		return placeholder
+	}},
+	/* m_Doc_go_seq0_star_go_seq0_recover1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: ";"},
+	/* m_Farthest */ {kind: machineRoot, children: []int{9}, memo: true},
+	/* m_Farthest_alt */ {kind: machineAlternate, children: []int{10, 16, 20, 30, 38, 45, 50, 57, 62}, memo: false},
+	/* m_Farthest_alt0_contents */ {kind: machineContents, children: []int{11}, memo: false},
+	/* m_Farthest_alt0_contents_seq */ {kind: machineSequence, children: []int{12, 13}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+				V2 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 string
+			V1 string
+			V2 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt0_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "1"},
+	/* m_Farthest_alt0_contents_seq1_seq */ {kind: machineSequence, children: []int{76, 14, 15}, memo: true, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Farthest_alt0_contents_seq1_seq1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "b"},
+	/* m_Farthest_alt0_contents_seq1_seq2_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "c"},
+	/* m_Farthest_alt1_contents */ {kind: machineContents, children: []int{17}, memo: false},
+	/* m_Farthest_alt1_contents_seq */ {kind: machineSequence, children: []int{18, 19}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt1_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "2"},
+	/* m_Farthest_alt1_contents_seq1_alt */ {kind: machineAlternate, children: []int{76, 14, 124}, memo: false},
+	/* m_Farthest_alt2_contents */ {kind: machineContents, children: []int{21}, memo: false},
+	/* m_Farthest_alt2_contents_seq */ {kind: machineSequence, children: []int{22, 23}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt2_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "3"},
+	/* m_Farthest_alt2_contents_seq1_alt */ {kind: machineAlternate, children: []int{24, 26, 29}, memo: false},
+	/* m_Farthest_alt2_contents_seq1_alt0_contents */ {kind: machineContents, children: []int{25}, memo: false},
+	/* m_Farthest_alt2_contents_seq1_alt0_contents_seq */ {kind: machineSequence, children: []int{76, 14}, memo: true, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt2_contents_seq1_alt1_contents */ {kind: machineContents, children: []int{27}, memo: false},
+	/* m_Farthest_alt2_contents_seq1_alt1_contents_seq */ {kind: machineSequence, children: []int{76, 15, 28}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "d"},
+	/* m_Farthest_alt2_contents_seq1_alt2_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "e"},
+	/* m_Farthest_alt3_contents */ {kind: machineContents, children: []int{31}, memo: false},
+	/* m_Farthest_alt3_contents_seq */ {kind: machineSequence, children: []int{32, 33}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 string
+			V1 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt3_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "4"},
+	/* m_Farthest_alt3_contents_seq1_seq */ {kind: machineSequence, children: []int{34, 35}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt3_contents_seq1_seq0_alt */ {kind: machineAlternate, children: []int{76, 14}, memo: false},
+	/* m_Farthest_alt3_contents_seq1_seq1_alt */ {kind: machineAlternate, children: []int{36, 29}, memo: false},
+	/* m_Farthest_alt3_contents_seq1_seq1_alt0_contents */ {kind: machineContents, children: []int{37}, memo: false},
+	/* m_Farthest_alt3_contents_seq1_seq1_alt0_contents_seq */ {kind: machineSequence, children: []int{15, 28}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt4_contents */ {kind: machineContents, children: []int{39}, memo: false},
+	/* m_Farthest_alt4_contents_seq */ {kind: machineSequence, children: []int{40, 41}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 string
+			V1 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt4_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "5"},
+	/* m_Farthest_alt4_contents_seq1_alt */ {kind: machineAlternate, children: []int{42, 44}, memo: false},
+	/* m_Farthest_alt4_contents_seq1_alt0_seq */ {kind: machineSequence, children: []int{76, 43}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt4_contents_seq1_alt0_seq1_alt */ {kind: machineAlternate, children: []int{14, 15}, memo: false},
+	/* m_Farthest_alt4_contents_seq1_alt1_seq */ {kind: machineSequence, children: []int{76, 28}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt5_contents */ {kind: machineContents, children: []int{46}, memo: false},
+	/* m_Farthest_alt5_contents_seq */ {kind: machineSequence, children: []int{47, 48}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 []string
+				V1 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 []string
+			V1 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt5_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "6"},
+	/* m_Farthest_alt5_contents_seq1_seq */ {kind: machineSequence, children: []int{49, 14}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 []string
+			V1 string
+		}
+		result.V0, _ = values[0].([]string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt5_contents_seq1_seq0_star */ {kind: machineStar, children: []int{76}, memo: false, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_Farthest_alt6_contents */ {kind: machineContents, children: []int{51}, memo: false},
+	/* m_Farthest_alt6_contents_seq */ {kind: machineSequence, children: []int{52, 53}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 string
+				V1 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 string
+			V1 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt6_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "7"},
+	/* m_Farthest_alt6_contents_seq1_seq */ {kind: machineSequence, children: []int{54, 56}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt6_contents_seq1_seq0_alt */ {kind: machineAlternate, children: []int{55, 76}, memo: false},
+	/* m_Farthest_alt6_contents_seq1_seq0_alt0_contents */ {kind: machineContents, children: []int{13}, memo: false},
+	/* m_Farthest_alt6_contents_seq1_seq1_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "z"},
+	/* m_Farthest_alt7_contents */ {kind: machineContents, children: []int{58}, memo: false},
+	/* m_Farthest_alt7_contents_seq */ {kind: machineSequence, children: []int{59, 60}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct {
+				V0 struct{}
+				V1 string
+				V2 string
+			}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		})
+		return result
+	}},
+	/* m_Farthest_alt7_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "8"},
+	/* m_Farthest_alt7_contents_seq1_seq */ {kind: machineSequence, children: []int{61, 76, 15}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 struct{}
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(struct{})
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Farthest_alt7_contents_seq1_seq0_not */ {kind: machineNot, children: []int{25}, memo: false, text: "\"a\" then \"b\""},
+	/* m_Farthest_alt8_contents */ {kind: machineContents, children: []int{63}, memo: false},
+	/* m_Farthest_alt8_contents_seq */ {kind: machineSequence, children: []int{64, 65}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Farthest_alt8_contents_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "9"},
+	/* m_Farthest_alt8_contents_seq1_alt */ {kind: machineAlternate, children: []int{76, 66}, memo: false},
+	/* m_Farthest_alt8_contents_seq1_alt1_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "ab"},
+	/* m_Items */ {kind: machineRoot, children: []int{68}, memo: true},
+	/* m_Items_star */ {kind: machineStar, children: []int{69}, memo: false, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_Items_star_recover */ {kind: machineRecover, children: []int{70, 7}, memo: false, apply: func(interface{}) interface{} {
+		var placeholder string = "?"
+		return placeholder
+	}},
+	/* m_Items_star_recover0_go */ {kind: machineGo, children: []int{71}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+		}) string {
+			return arg.V0
+		}(arg)
+	}},
+	/* m_Items_star_recover0_go_seq */ {kind: machineSequence, children: []int{103, 131, 7}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Letters */ {kind: machineRoot, children: []int{73}, memo: true},
+	/* m_Letters_star */ {kind: machineStar, children: []int{74}, memo: false, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_Letters_star_alt */ {kind: machineAlternate, children: []int{75, 76}, memo: false},
+	/* m_Letters_star_alt0_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "b", match: resourcem_Letters_star_alt0_regexRegex.FindIndex, read: resourcem_Letters_star_alt0_regexRegex.FindReaderIndex},
+	/* m_Letters_star_alt1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "a"},
+	/* m_Nest */ {kind: machineRoot, children: []int{78}, memo: true},
+	/* m_Nest_go */ {kind: machineGo, children: []int{79}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 struct{}
+			V1 string
+		})
+		return func(arg struct {
+			V0 struct{}
+			V1 string
+		}) string {
+			return arg.V1
+		}(arg)
+	}},
+	/* m_Nest_go_seq */ {kind: machineSequence, children: []int{80, 109}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 struct{}
+			V1 string
+		}
+		result.V0, _ = values[0].(struct{})
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Nest_go_seq0_not */ {kind: machineNot, children: []int{81}, memo: false, text: "nest then \"!\""},
+	/* m_Nest_go_seq0_not_seq */ {kind: machineSequence, children: []int{109, 152}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_Number */ {kind: machineRoot, children: []int{116}, memo: true},
+	/* m_Word */ {kind: machineRoot, children: []int{84}, memo: true},
+	/* m_Word_alt */ {kind: machineAlternate, children: []int{85, 94}, memo: false},
+	/* m_Word_alt0_go */ {kind: machineGo, children: []int{86}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		})
+		return func(arg struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}) string {
+			return arg.V2
+		}(arg)
+	}},
+	/* m_Word_alt0_go_seq */ {kind: machineSequence, children: []int{87, 90, 93}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 struct{}
+			V1 struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}
+			V2 string
+		}
+		result.V0, _ = values[0].(struct{})
+		result.V1, _ = values[1].(struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		})
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Word_alt0_go_seq0_not */ {kind: machineNot, children: []int{88}, memo: false, text: "\"if\" then \"(\""},
+	/* m_Word_alt0_go_seq0_not_seq */ {kind: machineSequence, children: []int{89, 137, 147}, memo: false, commit: 2, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct{})
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Word_alt0_go_seq0_not_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "if"},
+	/* m_Word_alt0_go_seq1_and */ {kind: machineAnd, children: []int{91}, memo: false},
+	/* m_Word_alt0_go_seq1_and_seq */ {kind: machineSequence, children: []int{92, 137, 92}, memo: false, commit: 2, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct{})
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_Word_alt0_go_seq1_and_seq0_regex */ {kind: machineRegex, children: []int{}, memo: true, text: "[a-z]", match: resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindIndex, read: resourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindReaderIndex},
+	/* m_Word_alt0_go_seq2_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "[a-z]+", match: resourcem_Word_alt0_go_seq2_regexRegex.FindIndex, read: resourcem_Word_alt0_go_seq2_regexRegex.FindReaderIndex},
+	/* m_Word_alt1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "x"},
+	/* m_keyword */ {kind: machineRoot, children: []int{96}, memo: true},
+	/* m_keyword_go */ {kind: machineGo, children: []int{97}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 struct{}
+		})
+		return func(arg struct {
+			V0 string
+			V1 struct{}
+		}) string {
+			return arg.V0
+		}(arg)
+	}},
+	/* m_keyword_go_seq */ {kind: machineSequence, children: []int{98, 101}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct{}
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct{})
+		return result
+	}},
+	/* m_keyword_go_seq0_alt */ {kind: machineAlternate, children: []int{99, 100}, memo: false},
+	/* m_keyword_go_seq0_alt0_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "let"},
+	/* m_keyword_go_seq0_alt1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "print"},
+	/* m_keyword_go_seq1_not */ {kind: machineNot, children: []int{102}, memo: true, text: "text matching `[a-z0-9]`"},
+	/* m_keyword_go_seq1_not_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "[a-z0-9]", match: resourcem_keyword_go_seq1_not_regexRegex.FindIndex, read: resourcem_keyword_go_seq1_not_regexRegex.FindReaderIndex},
+	/* m_name */ {kind: machineRoot, children: []int{104}, memo: true},
+	/* m_name_alias */ {kind: machineAlias, children: []int{105}, memo: false, text: "name"},
+	/* m_name_alias_go */ {kind: machineGo, children: []int{106}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}) string {
+			return arg.V2
+		}(arg)
+	}},
+	/* m_name_alias_go_seq */ {kind: machineSequence, children: []int{131, 107, 108}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 struct{}
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(struct{})
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_name_alias_go_seq1_not */ {kind: machineNot, children: []int{95}, memo: false, text: "keyword"},
+	/* m_name_alias_go_seq2_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "[a-z][a-z0-9]*", match: resourcem_name_alias_go_seq2_regexRegex.FindIndex, read: resourcem_name_alias_go_seq2_regexRegex.FindReaderIndex},
+	/* m_nest */ {kind: machineRoot, children: []int{110}, memo: true},
+	/* m_nest_alt */ {kind: machineAlternate, children: []int{111, 113, 94}, memo: false},
+	/* m_nest_alt0_go */ {kind: machineGo, children: []int{112}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+		}) string {
+			return arg.V1
+		}(arg)
+	}},
+	/* m_nest_alt0_go_seq */ {kind: machineSequence, children: []int{147, 109, 149}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_nest_alt1_go */ {kind: machineGo, children: []int{114}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+		}) string {
+			return arg.V1
+		}(arg)
+	}},
+	/* m_nest_alt1_go_seq */ {kind: machineSequence, children: []int{147, 109, 115}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		return result
+	}},
+	/* m_nest_alt1_go_seq2_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "]"},
+	/* m_number */ {kind: machineRoot, children: []int{117}, memo: true},
+	/* m_number_go */ {kind: machineGo, children: []int{118}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 float64
+		})
+		return func(arg struct {
+			V0 string
+			V1 float64
+		}) string {
+			return strconv.FormatFloat(arg.V1, 'g', -1, 64)
+		}(arg)
+	}},
+	/* m_number_go_seq */ {kind: machineSequence, children: []int{131, 119}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 float64
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(float64)
+		return result
+	}},
+	/* m_number_go_seq1_alias */ {kind: machineAlias, children: []int{120}, memo: false, text: "number"},
+	/* m_number_go_seq1_alias_try */ {kind: machineTry, children: []int{121}, memo: false, fatal: false, try: func(value interface{}) (interface{}, error) {
+		arg, _ := value.(string)
+		return func(arg string) (float64, error) {
+			return strconv.ParseFloat(arg, 64)
+		}(arg)
+	}},
+	/* m_number_go_seq1_alias_try_contents */ {kind: machineContents, children: []int{122}, memo: false},
+	/* m_number_go_seq1_alias_try_contents_seq */ {kind: machineSequence, children: []int{123, 125, 126, 129}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 []string
+			V2 *struct {
+				V0 string
+				V1 []string
+			}
+			V3 *string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].([]string)
+		result.V2, _ = values[2].(*struct {
+			V0 string
+			V1 []string
+		})
+		result.V3, _ = values[3].(*string)
+		return result
+	}},
+	/* m_number_go_seq1_alias_try_contents_seq0_and */ {kind: machineAnd, children: []int{124}, memo: false},
+	/* m_number_go_seq1_alias_try_contents_seq0_and_regex */ {kind: machineRegex, children: []int{}, memo: true, text: "[0-9]", match: resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindIndex, read: resourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindReaderIndex},
+	/* m_number_go_seq1_alias_try_contents_seq1_plus */ {kind: machinePlus, children: []int{124}, memo: true, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_number_go_seq1_alias_try_contents_seq2_opt */ {kind: machineOptional, children: []int{127}, memo: false, apply: func(value interface{}) interface{} {
+		element, _ := value.(struct {
+			V0 string
+			V1 []string
+		})
+		return &element
+	}},
+	/* m_number_go_seq1_alias_try_contents_seq2_opt_seq */ {kind: machineSequence, children: []int{128, 125}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 []string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].([]string)
+		return result
+	}},
+	/* m_number_go_seq1_alias_try_contents_seq2_opt_seq0_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "."},
+	/* m_number_go_seq1_alias_try_contents_seq3_opt */ {kind: machineOptional, children: []int{130}, memo: false, apply: func(value interface{}) interface{} {
+		element, _ := value.(string)
+		return &element
+	}},
+	/* m_number_go_seq1_alias_try_contents_seq3_opt_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "e[0-9]+", match: resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindIndex, read: resourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindReaderIndex},
+	/* m_space */ {kind: machineRoot, children: []int{132}, memo: true},
+	/* m_space_regex */ {kind: machineRegex, children: []int{}, memo: false, text: "[ \\t\\r\\n]*", match: resourcem_space_regexRegex.FindIndex, read: resourcem_space_regexRegex.FindReaderIndex},
+	/* m_statement */ {kind: machineRoot, children: []int{134}, memo: true},
+	/* m_statement_alt */ {kind: machineAlternate, children: []int{135, 140}, memo: false},
+	/* m_statement_alt0_go */ {kind: machineGo, children: []int{136}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}) string {
+			return arg.V4 + "=" + arg.V7
+		}(arg)
+	}},
+	/* m_statement_alt0_go_seq */ {kind: machineSequence, children: []int{131, 99, 101, 137, 103, 131, 138, 143}, memo: false, commit: 4, opens: true, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 string
+			V5 string
+			V6 string
+			V7 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(struct{})
+		result.V3, _ = values[3].(struct{})
+		result.V4, _ = values[4].(string)
+		result.V5, _ = values[5].(string)
+		result.V6, _ = values[6].(string)
+		result.V7, _ = values[7].(string)
+		return result
+	}},
+	/* m_statement_alt0_go_seq3_cut */ {kind: machineCut, children: []int{}, memo: true},
+	/* m_statement_alt0_go_seq6_label */ {kind: machineLabel, children: []int{139}, memo: false, text: "missing equals sign", opening: false},
+	/* m_statement_alt0_go_seq6_label_lit */ {kind: machineLiteral, children: []int{}, memo: false, text: "="},
+	/* m_statement_alt1_go */ {kind: machineGo, children: []int{141}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}) string {
+			return "print " + strings.Join(arg.V4, " ")
+		}(arg)
+	}},
+	/* m_statement_alt1_go_seq */ {kind: machineSequence, children: []int{131, 100, 101, 137, 142}, memo: false, commit: 4, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 struct{}
+			V4 []string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(struct{})
+		result.V3, _ = values[3].(struct{})
+		result.V4, _ = values[4].([]string)
+		return result
+	}},
+	/* m_statement_alt1_go_seq4_plus */ {kind: machinePlus, children: []int{143}, memo: false, build: func(values []interface{}) interface{} {
+		result := []string{}
+		for _, value := range values {
+			element, _ := value.(string)
+			result = append(result, element)
+		}
+		return result
+	}},
+	/* m_value */ {kind: machineRoot, children: []int{144}, memo: true},
+	/* m_value_alt */ {kind: machineAlternate, children: []int{116, 103, 145, 150}, memo: true},
+	/* m_value_alt2_go */ {kind: machineGo, children: []int{146}, memo: false, apply: func(value interface{}) interface{} {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}) string {
+			return "(" + arg.V2 + ")"
+		}(arg)
+	}},
+	/* m_value_alt2_go_seq */ {kind: machineSequence, children: []int{131, 147, 143, 131, 148}, memo: false, opens: true, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		result.V2, _ = values[2].(string)
+		result.V3, _ = values[3].(string)
+		result.V4, _ = values[4].(string)
+		return result
+	}},
+	/* m_value_alt2_go_seq1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "("},
+	/* m_value_alt2_go_seq4_label */ {kind: machineLabel, children: []int{149}, memo: false, text: "unclosed parenthesis", opening: true},
+	/* m_value_alt2_go_seq4_label_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: ")"},
+	/* m_value_alt3_try */ {kind: machineTry, children: []int{151}, memo: false, fatal: true, try: func(value interface{}) (interface{}, error) {
+		arg, _ := value.(struct {
+			V0 string
+			V1 string
+		})
+		return func(arg struct {
+			V0 string
+			V1 string
+		}) (string, error) {
+			return "", errors.New("values can't be shouted")
+		}(arg)
+	}},
+	/* m_value_alt3_try_seq */ {kind: machineSequence, children: []int{131, 152}, memo: false, build: func(values []interface{}) interface{} {
+		var result struct {
+			V0 string
+			V1 string
+		}
+		result.V0, _ = values[0].(string)
+		result.V1, _ = values[1].(string)
+		return result
+	}},
+	/* m_value_alt3_try_seq1_lit */ {kind: machineLiteral, children: []int{}, memo: true, text: "!"},
+}
+
+const (
+	machineRoot = iota
+	machineLiteral
+	machineSequence
+	machineAlternate
+	machineStar
+	machinePlus
+	machineNot
+	machineAnd
+	machineGo
+	machineRegex
+	machineContents
+	machineOptional
+	machineAlias
+	machineCut
+	machineLabel
+	machineRecover
+	machineTry
+)
+
+type machineNode struct {
+	kind     int
+	children []int
+	memo     bool
+	commit   int                                    // How many children of a sequence precede and include its cut, if it has one
+	opens    bool                                   // Whether a sequence has a label after its first child, so holds its start
+	fatal    bool                                   // Whether a try's error is fatal
+	opening  bool                                   // Whether a label's message says where its sequence began
+	text     string                                 // The literal, regex, expression excluded, alias or label
+	match    func([]byte) []int                     // Finds the regex
+	read     func(io.RuneReader) []int              // Finds the regex in streamed input
+	build    func([]interface{}) interface{}        // Builds the value of a sequence, star or plus
+	apply    func(interface{}) interface{}          // Computes the value of a go or optional, or a recover's placeholder
+	try      func(interface{}) (interface{}, error) // Computes the value of a try, or its error
+}
+
+type machineFrame struct {
+	node   int // The node being parsed
+	start  int // Where the node began
+	here   int // How far the node has got
+	step   int // How many children have been started
+	mark   int // The node's hold, if it has one
+	values []interface{}
+	failed peg.Result // The farthest failure of an alternate's children
+	named  peg.Mark   // The failures tracked when an alias or a try began
+
+	recovered []*peg.ParseError // The errors recovered from by the node's children, or the node itself
+}
+
+// machineHolds says whether the node holds its position while its children are
+// parsed, since it may go back to it or need its input.
+func machineHolds(node *machineNode) bool {
+	switch node.kind {
+	case machineAlternate, machineStar, machinePlus, machineNot, machineAnd, machineContents, machineOptional, machineRecover:
+		return true
+	}
+	return node.opens
+}
+
+// machineEntry is a memoized result. It's silent if it was found inside a
+// negative lookahead, whose failures weren't recorded, so only another
+// lookahead may use it; outside of one it's parsed again and replaced.
+type machineEntry struct {
+	result peg.Result
+	value  interface{}
+	silent bool
+}
+
+// run parses the given node. When a frame asks for a child to be parsed, the
+// child is pushed onto the stack; when it finishes, result and value hold its
+// outcome for the frame below it.
+func (session *Session) run(start int, here int) (peg.Result, interface{}) {
+	stack := append(session.stack[:0], machineFrame{node: start, start: here, here: here})
+	session.enter(here)
+	var result peg.Result
+	var value interface{}
+	for {
+		frame := &stack[len(stack)-1]
+		node := &machine[frame.node]
+		entry, recalled := machineEntry{}, false
+		if frame.step == 0 && node.memo {
+			entry, recalled = session.recall(frame.node, frame.start)
+			recalled = recalled && (!entry.silent || session.failures.Silent != 0)
+		}
+		if recalled {
+			result, value = entry.result, entry.value
+		} else {
+			next := -1
+			switch node.kind {
+			case machineRoot:
+				if frame.step == 0 {
+					next = node.children[0]
+				}
+			case machineLiteral:
+				here := frame.here
+				if !session.available(here, here+len(node.text)) || string(session.slice(here, here+len(node.text))) != node.text {
+					result, value = session.failures.Fail(here, peg.Expected{Token: node.text}), ""
+				} else {
+					result, value = peg.Success(here+len(node.text)), node.text
+				}
+			case machineSequence:
+				if frame.step > 0 {
+					if !result.Ok {
+						result.Fatal = result.Fatal || node.commit > 0 && frame.step > node.commit
+						if frame.step > 1 && machine[node.children[frame.step-1]].kind == machineLabel {
+							result = session.failures.Open(result, frame.start)
+						}
+						value = nil
+						break
+					}
+					frame.here = result.At
+					frame.values = append(frame.values, value)
+					frame.recovered = append(frame.recovered, result.Recovered...)
+				}
+				if frame.step < len(node.children) {
+					next = node.children[frame.step]
+					break
+				}
+				result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.build(frame.values)
+			case machineAlternate:
+				if frame.step == 0 {
+					frame.failed = peg.Result{At: frame.start}
+				} else {
+					if result.Ok || result.Fatal {
+						break
+					}
+					frame.failed = peg.Farthest(frame.failed, result)
+				}
+				if frame.step < len(node.children) {
+					next = node.children[frame.step]
+					break
+				}
+				result, value = frame.failed, nil
+			case machineStar, machinePlus:
+				if frame.step > 0 {
+					if !result.Ok && (node.kind == machinePlus && len(frame.values) == 0 || result.Fatal) {
+						value = nil
+						break
+					}
+					if !result.Ok || result.At == frame.here && (node.kind == machineStar || len(frame.values) != 0) {
+						if result.Ok {
+							session.failures.Discard(result.Recovered)
+						}
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.build(frame.values)
+						break
+					}
+					frame.here = result.At
+					frame.values = append(frame.values, value)
+					frame.recovered = append(frame.recovered, result.Recovered...)
+					session.advance(frame.mark, frame.here)
+				}
+				next = node.children[0]
+			case machineNot:
+				if frame.step == 0 {
+					next = node.children[0]
+					session.failures.Silent++
+				} else if session.failures.Silent--; !result.Ok {
+					result, value = peg.Success(frame.start), struct{}{}
+				} else {
+					result, value = session.failures.Fail(frame.start, peg.Exclude{Message: node.text}), struct{}{}
+				}
+			case machineAnd:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					result.Fatal, value = false, nil
+				} else {
+					result = peg.Success(frame.start)
+				}
+			case machineGo:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					value = nil
+				} else {
+					value = node.apply(value)
+				}
+			case machineTry:
+				if frame.step == 0 {
+					if !node.fatal {
+						frame.named = session.failures.Save()
+					}
+					next = node.children[0]
+				} else if !result.Ok {
+					value = nil
+				} else if answer, err := node.try(value); err == nil {
+					value = answer
+				} else if node.fatal {
+					result, value = session.failures.Abort(frame.start, peg.Invalid{Err: err}), answer
+				} else {
+					result, value = session.failures.Reject(frame.named, frame.start, result.At, peg.Invalid{Err: err}), answer
+				}
+			case machineRegex:
+				here := frame.here
+				var match []int
+				if session.source == nil {
+					match = node.match(session.slice(here, session.end()))
+				} else {
+					match = node.read(session.runes(here))
+				}
+				if match == nil {
+					result, value = session.failures.Fail(here, peg.ExpectedPattern{Regex: node.text}), ""
+				} else {
+					result, value = peg.Success(here+match[1]), string(session.slice(here, here+match[1]))
+				}
+			case machineContents:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					value = ""
+				} else {
+					value = string(session.slice(frame.start, result.At))
+				}
+			case machineAlias:
+				if frame.step == 0 {
+					frame.named = session.failures.Mark()
+					next = node.children[0]
+				} else if !result.Ok {
+					result = session.failures.Name(frame.named, session.named(frame.start, result.At), result, node.text)
+				}
+			case machineLabel:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					result = session.failures.Label(frame.start, node.text, node.opening)
+				}
+			case machineRecover:
+				switch {
+				case frame.step == 0:
+					next = node.children[0]
+				case frame.step == 1 && (result.Ok || session.failures.Silent != 0):
+				default:
+					if frame.step == 1 {
+						frame.recovered = []*peg.ParseError{session.failure(result)}
+						session.failures.Clear()
+					} else if session.failures.Silent--; !result.Ok {
+						frame.here = session.next(frame.here)
+					} else {
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.apply(nil)
+						break
+					}
+					if !session.available(frame.start, frame.here+1) {
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.apply(nil)
+						break
+					}
+					session.failures.Silent++
+					next = node.children[1]
+				}
+			case machineCut:
+				result, value = peg.Success(frame.here), struct{}{}
+			case machineOptional:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if result.Fatal {
+					value = nil
+				} else if !result.Ok {
+					result, value = peg.Success(frame.start), nil
+				} else {
+					value = node.apply(value)
+				}
+			}
+			if next >= 0 {
+				if frame.step == 0 && machineHolds(node) {
+					frame.mark = session.hold(frame.start)
+				}
+				frame.step++
+				stack = append(stack, machineFrame{node: next, start: frame.here, here: frame.here})
+				session.enter(frame.here)
+				continue
+			}
+			if frame.step > 0 && machineHolds(node) {
+				session.release(frame.mark)
+			}
+			if node.memo {
+				session.remember(frame.node, frame.start, machineEntry{result, value, session.failures.Silent != 0})
+			}
+		}
+		stack = stack[:len(stack)-1]
+		session.depth--
+		if len(stack) == 0 {
+			session.stack = stack
+			return result, value
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package twin
+
+// The package may declare the names the runtime package does, since generated
+// parsers import it under a name of their own.
+type (
+	Position struct{}
+	Result   struct{}
+	Limits   struct{}
+)
+
+var Start = Position{}
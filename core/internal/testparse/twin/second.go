@@ -0,0 +1,5302 @@
+package twin
+
+import "context"
+import "errors"
+import "io"
+import "regexp"
+import "strconv"
+import "strings"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
+
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type SecondParser struct{}
+
+func NewSecondParser() SecondParser {
+	return SecondParser{}
+}
+
+// ParseDoc parses the whole input as Doc, in a fresh session.
+func (parser SecondParser) ParseDoc(input []byte) ([]string, error) {
+	return parser.NewSession(input).Doc()
+}
+
+// ParseDocPrefix parses as much of the input as Doc matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseDocPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).DocPrefix()
+}
+
+// ParseDocContext parses the input as Doc, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseDocContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Doc()
+}
+
+// ParseDocReader parses the input read from the source as Doc, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseDocReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Doc()
+}
+
+// ParseFarthest parses the whole input as Farthest, in a fresh session.
+func (parser SecondParser) ParseFarthest(input []byte) (string, error) {
+	return parser.NewSession(input).Farthest()
+}
+
+// ParseFarthestPrefix parses as much of the input as Farthest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseFarthestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).FarthestPrefix()
+}
+
+// ParseFarthestContext parses the input as Farthest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseFarthestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Farthest()
+}
+
+// ParseFarthestReader parses the input read from the source as Farthest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseFarthestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Farthest()
+}
+
+// ParseItems parses the whole input as Items, in a fresh session.
+func (parser SecondParser) ParseItems(input []byte) ([]string, error) {
+	return parser.NewSession(input).Items()
+}
+
+// ParseItemsPrefix parses as much of the input as Items matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseItemsPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).ItemsPrefix()
+}
+
+// ParseItemsContext parses the input as Items, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseItemsContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Items()
+}
+
+// ParseItemsReader parses the input read from the source as Items, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseItemsReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Items()
+}
+
+// ParseLetters parses the whole input as Letters, in a fresh session.
+func (parser SecondParser) ParseLetters(input []byte) ([]string, error) {
+	return parser.NewSession(input).Letters()
+}
+
+// ParseLettersPrefix parses as much of the input as Letters matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseLettersPrefix(input []byte) ([]string, int, error) {
+	return parser.NewSession(input).LettersPrefix()
+}
+
+// ParseLettersContext parses the input as Letters, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseLettersContext(ctx context.Context, input []byte, limits peg.Limits) ([]string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Letters()
+}
+
+// ParseLettersReader parses the input read from the source as Letters, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseLettersReader(source io.Reader) ([]string, error) {
+	return parser.NewReaderSession(source).Letters()
+}
+
+// ParseNest parses the whole input as Nest, in a fresh session.
+func (parser SecondParser) ParseNest(input []byte) (string, error) {
+	return parser.NewSession(input).Nest()
+}
+
+// ParseNestPrefix parses as much of the input as Nest matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseNestPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NestPrefix()
+}
+
+// ParseNestContext parses the input as Nest, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseNestContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Nest()
+}
+
+// ParseNestReader parses the input read from the source as Nest, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseNestReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Nest()
+}
+
+// ParseNumber parses the whole input as Number, in a fresh session.
+func (parser SecondParser) ParseNumber(input []byte) (string, error) {
+	return parser.NewSession(input).Number()
+}
+
+// ParseNumberPrefix parses as much of the input as Number matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseNumberPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).NumberPrefix()
+}
+
+// ParseNumberContext parses the input as Number, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseNumberContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Number()
+}
+
+// ParseNumberReader parses the input read from the source as Number, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseNumberReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Number()
+}
+
+// ParseWord parses the whole input as Word, in a fresh session.
+func (parser SecondParser) ParseWord(input []byte) (string, error) {
+	return parser.NewSession(input).Word()
+}
+
+// ParseWordPrefix parses as much of the input as Word matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser SecondParser) ParseWordPrefix(input []byte) (string, int, error) {
+	return parser.NewSession(input).WordPrefix()
+}
+
+// ParseWordContext parses the input as Word, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser SecondParser) ParseWordContext(ctx context.Context, input []byte, limits peg.Limits) (string, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Word()
+}
+
+// ParseWordReader parses the input read from the source as Word, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser SecondParser) ParseWordReader(source io.Reader) (string, error) {
+	return parser.NewReaderSession(source).Word()
+}
+
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type SecondSession struct {
+	secondBuffer
+	secondLimiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
+	// Internal memoization tables
+	wherem_Doc                                   map[int]peg.Result
+	whatm_Doc                                    map[int][]string
+	wherem_Doc_go_seq0_star_go_seq0_recover1_lit map[int]peg.Result
+	whatm_Doc_go_seq0_star_go_seq0_recover1_lit  map[int]string
+	wherem_Farthest                              map[int]peg.Result
+	whatm_Farthest                               map[int]string
+	wherem_Farthest_alt0_contents_seq1_seq       map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq        map[int]struct {
+		V0 string
+		V1 string
+		V2 string
+	}
+	wherem_Farthest_alt0_contents_seq1_seq1_lit          map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq1_lit           map[int]string
+	wherem_Farthest_alt0_contents_seq1_seq2_lit          map[int]peg.Result
+	whatm_Farthest_alt0_contents_seq1_seq2_lit           map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt0_contents_seq map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt0_contents_seq  map[int]struct {
+		V0 string
+		V1 string
+	}
+	wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit  map[int]string
+	wherem_Farthest_alt2_contents_seq1_alt2_lit               map[int]peg.Result
+	whatm_Farthest_alt2_contents_seq1_alt2_lit                map[int]string
+	wherem_Items                                              map[int]peg.Result
+	whatm_Items                                               map[int][]string
+	wherem_Letters                                            map[int]peg.Result
+	whatm_Letters                                             map[int][]string
+	wherem_Letters_star_alt1_lit                              map[int]peg.Result
+	whatm_Letters_star_alt1_lit                               map[int]string
+	wherem_Nest                                               map[int]peg.Result
+	whatm_Nest                                                map[int]string
+	wherem_Number                                             map[int]peg.Result
+	whatm_Number                                              map[int]string
+	wherem_Word                                               map[int]peg.Result
+	whatm_Word                                                map[int]string
+	wherem_Word_alt0_go_seq1_and_seq0_regex                   map[int]peg.Result
+	whatm_Word_alt0_go_seq1_and_seq0_regex                    map[int]string
+	wherem_Word_alt1_lit                                      map[int]peg.Result
+	whatm_Word_alt1_lit                                       map[int]string
+	wherem_keyword                                            map[int]peg.Result
+	whatm_keyword                                             map[int]string
+	wherem_keyword_go_seq0_alt0_lit                           map[int]peg.Result
+	whatm_keyword_go_seq0_alt0_lit                            map[int]string
+	wherem_keyword_go_seq0_alt1_lit                           map[int]peg.Result
+	whatm_keyword_go_seq0_alt1_lit                            map[int]string
+	wherem_keyword_go_seq1_not                                map[int]peg.Result
+	whatm_keyword_go_seq1_not                                 map[int]struct{}
+	wherem_name                                               map[int]peg.Result
+	whatm_name                                                map[int]string
+	wherem_nest                                               map[int]peg.Result
+	whatm_nest                                                map[int]string
+	wherem_number                                             map[int]peg.Result
+	whatm_number                                              map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq0_and_regex   map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq0_and_regex    map[int]string
+	wherem_number_go_seq1_alias_try_contents_seq1_plus        map[int]peg.Result
+	whatm_number_go_seq1_alias_try_contents_seq1_plus         map[int][]string
+	wherem_space                                              map[int]peg.Result
+	whatm_space                                               map[int]string
+	wherem_statement                                          map[int]peg.Result
+	whatm_statement                                           map[int]string
+	wherem_statement_alt0_go_seq3_cut                         map[int]peg.Result
+	whatm_statement_alt0_go_seq3_cut                          map[int]struct{}
+	wherem_value                                              map[int]peg.Result
+	whatm_value                                               map[int]string
+	wherem_value_alt                                          map[int]peg.Result
+	whatm_value_alt                                           map[int]string
+	wherem_value_alt2_go_seq1_lit                             map[int]peg.Result
+	whatm_value_alt2_go_seq1_lit                              map[int]string
+	wherem_value_alt2_go_seq4_label_lit                       map[int]peg.Result
+	whatm_value_alt2_go_seq4_label_lit                        map[int]string
+	wherem_value_alt3_try_seq1_lit                            map[int]peg.Result
+	whatm_value_alt3_try_seq1_lit                             map[int]string
+}
+
+func (parser SecondParser) NewSession(input []byte) *SecondSession {
+	session := &SecondSession{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser SecondParser) NewReaderSession(source io.Reader) *SecondSession {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *SecondSession) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *SecondSession) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *SecondSession) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.wherem_Doc == nil {
+		session.wherem_Doc = map[int]peg.Result{}
+		session.whatm_Doc = map[int][]string{}
+	}
+	for key := range session.wherem_Doc {
+		delete(session.wherem_Doc, key)
+		delete(session.whatm_Doc, key)
+	}
+	if session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit == nil {
+		session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit = map[int]peg.Result{}
+		session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit {
+		delete(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+		delete(session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+	}
+	if session.wherem_Farthest == nil {
+		session.wherem_Farthest = map[int]peg.Result{}
+		session.whatm_Farthest = map[int]string{}
+	}
+	for key := range session.wherem_Farthest {
+		delete(session.wherem_Farthest, key)
+		delete(session.whatm_Farthest, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq = map[int]struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq1_lit == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq1_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq1_lit {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq1_lit, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq1_lit, key)
+	}
+	if session.wherem_Farthest_alt0_contents_seq1_seq2_lit == nil {
+		session.wherem_Farthest_alt0_contents_seq1_seq2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt0_contents_seq1_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq2_lit {
+		delete(session.wherem_Farthest_alt0_contents_seq1_seq2_lit, key)
+		delete(session.whatm_Farthest_alt0_contents_seq1_seq2_lit, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq = map[int]struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+	}
+	if session.wherem_Farthest_alt2_contents_seq1_alt2_lit == nil {
+		session.wherem_Farthest_alt2_contents_seq1_alt2_lit = map[int]peg.Result{}
+		session.whatm_Farthest_alt2_contents_seq1_alt2_lit = map[int]string{}
+	}
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt2_lit {
+		delete(session.wherem_Farthest_alt2_contents_seq1_alt2_lit, key)
+		delete(session.whatm_Farthest_alt2_contents_seq1_alt2_lit, key)
+	}
+	if session.wherem_Items == nil {
+		session.wherem_Items = map[int]peg.Result{}
+		session.whatm_Items = map[int][]string{}
+	}
+	for key := range session.wherem_Items {
+		delete(session.wherem_Items, key)
+		delete(session.whatm_Items, key)
+	}
+	if session.wherem_Letters == nil {
+		session.wherem_Letters = map[int]peg.Result{}
+		session.whatm_Letters = map[int][]string{}
+	}
+	for key := range session.wherem_Letters {
+		delete(session.wherem_Letters, key)
+		delete(session.whatm_Letters, key)
+	}
+	if session.wherem_Letters_star_alt1_lit == nil {
+		session.wherem_Letters_star_alt1_lit = map[int]peg.Result{}
+		session.whatm_Letters_star_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Letters_star_alt1_lit {
+		delete(session.wherem_Letters_star_alt1_lit, key)
+		delete(session.whatm_Letters_star_alt1_lit, key)
+	}
+	if session.wherem_Nest == nil {
+		session.wherem_Nest = map[int]peg.Result{}
+		session.whatm_Nest = map[int]string{}
+	}
+	for key := range session.wherem_Nest {
+		delete(session.wherem_Nest, key)
+		delete(session.whatm_Nest, key)
+	}
+	if session.wherem_Number == nil {
+		session.wherem_Number = map[int]peg.Result{}
+		session.whatm_Number = map[int]string{}
+	}
+	for key := range session.wherem_Number {
+		delete(session.wherem_Number, key)
+		delete(session.whatm_Number, key)
+	}
+	if session.wherem_Word == nil {
+		session.wherem_Word = map[int]peg.Result{}
+		session.whatm_Word = map[int]string{}
+	}
+	for key := range session.wherem_Word {
+		delete(session.wherem_Word, key)
+		delete(session.whatm_Word, key)
+	}
+	if session.wherem_Word_alt0_go_seq1_and_seq0_regex == nil {
+		session.wherem_Word_alt0_go_seq1_and_seq0_regex = map[int]peg.Result{}
+		session.whatm_Word_alt0_go_seq1_and_seq0_regex = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq0_regex {
+		delete(session.wherem_Word_alt0_go_seq1_and_seq0_regex, key)
+		delete(session.whatm_Word_alt0_go_seq1_and_seq0_regex, key)
+	}
+	if session.wherem_Word_alt1_lit == nil {
+		session.wherem_Word_alt1_lit = map[int]peg.Result{}
+		session.whatm_Word_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_Word_alt1_lit {
+		delete(session.wherem_Word_alt1_lit, key)
+		delete(session.whatm_Word_alt1_lit, key)
+	}
+	if session.wherem_keyword == nil {
+		session.wherem_keyword = map[int]peg.Result{}
+		session.whatm_keyword = map[int]string{}
+	}
+	for key := range session.wherem_keyword {
+		delete(session.wherem_keyword, key)
+		delete(session.whatm_keyword, key)
+	}
+	if session.wherem_keyword_go_seq0_alt0_lit == nil {
+		session.wherem_keyword_go_seq0_alt0_lit = map[int]peg.Result{}
+		session.whatm_keyword_go_seq0_alt0_lit = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq0_alt0_lit {
+		delete(session.wherem_keyword_go_seq0_alt0_lit, key)
+		delete(session.whatm_keyword_go_seq0_alt0_lit, key)
+	}
+	if session.wherem_keyword_go_seq0_alt1_lit == nil {
+		session.wherem_keyword_go_seq0_alt1_lit = map[int]peg.Result{}
+		session.whatm_keyword_go_seq0_alt1_lit = map[int]string{}
+	}
+	for key := range session.wherem_keyword_go_seq0_alt1_lit {
+		delete(session.wherem_keyword_go_seq0_alt1_lit, key)
+		delete(session.whatm_keyword_go_seq0_alt1_lit, key)
+	}
+	if session.wherem_keyword_go_seq1_not == nil {
+		session.wherem_keyword_go_seq1_not = map[int]peg.Result{}
+		session.whatm_keyword_go_seq1_not = map[int]struct{}{}
+	}
+	for key := range session.wherem_keyword_go_seq1_not {
+		delete(session.wherem_keyword_go_seq1_not, key)
+		delete(session.whatm_keyword_go_seq1_not, key)
+	}
+	if session.wherem_name == nil {
+		session.wherem_name = map[int]peg.Result{}
+		session.whatm_name = map[int]string{}
+	}
+	for key := range session.wherem_name {
+		delete(session.wherem_name, key)
+		delete(session.whatm_name, key)
+	}
+	if session.wherem_nest == nil {
+		session.wherem_nest = map[int]peg.Result{}
+		session.whatm_nest = map[int]string{}
+	}
+	for key := range session.wherem_nest {
+		delete(session.wherem_nest, key)
+		delete(session.whatm_nest, key)
+	}
+	if session.wherem_number == nil {
+		session.wherem_number = map[int]peg.Result{}
+		session.whatm_number = map[int]string{}
+	}
+	for key := range session.wherem_number {
+		delete(session.wherem_number, key)
+		delete(session.whatm_number, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex = map[int]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+	}
+	if session.wherem_number_go_seq1_alias_try_contents_seq1_plus == nil {
+		session.wherem_number_go_seq1_alias_try_contents_seq1_plus = map[int]peg.Result{}
+		session.whatm_number_go_seq1_alias_try_contents_seq1_plus = map[int][]string{}
+	}
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq1_plus {
+		delete(session.wherem_number_go_seq1_alias_try_contents_seq1_plus, key)
+		delete(session.whatm_number_go_seq1_alias_try_contents_seq1_plus, key)
+	}
+	if session.wherem_space == nil {
+		session.wherem_space = map[int]peg.Result{}
+		session.whatm_space = map[int]string{}
+	}
+	for key := range session.wherem_space {
+		delete(session.wherem_space, key)
+		delete(session.whatm_space, key)
+	}
+	if session.wherem_statement == nil {
+		session.wherem_statement = map[int]peg.Result{}
+		session.whatm_statement = map[int]string{}
+	}
+	for key := range session.wherem_statement {
+		delete(session.wherem_statement, key)
+		delete(session.whatm_statement, key)
+	}
+	if session.wherem_statement_alt0_go_seq3_cut == nil {
+		session.wherem_statement_alt0_go_seq3_cut = map[int]peg.Result{}
+		session.whatm_statement_alt0_go_seq3_cut = map[int]struct{}{}
+	}
+	for key := range session.wherem_statement_alt0_go_seq3_cut {
+		delete(session.wherem_statement_alt0_go_seq3_cut, key)
+		delete(session.whatm_statement_alt0_go_seq3_cut, key)
+	}
+	if session.wherem_value == nil {
+		session.wherem_value = map[int]peg.Result{}
+		session.whatm_value = map[int]string{}
+	}
+	for key := range session.wherem_value {
+		delete(session.wherem_value, key)
+		delete(session.whatm_value, key)
+	}
+	if session.wherem_value_alt == nil {
+		session.wherem_value_alt = map[int]peg.Result{}
+		session.whatm_value_alt = map[int]string{}
+	}
+	for key := range session.wherem_value_alt {
+		delete(session.wherem_value_alt, key)
+		delete(session.whatm_value_alt, key)
+	}
+	if session.wherem_value_alt2_go_seq1_lit == nil {
+		session.wherem_value_alt2_go_seq1_lit = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go_seq1_lit {
+		delete(session.wherem_value_alt2_go_seq1_lit, key)
+		delete(session.whatm_value_alt2_go_seq1_lit, key)
+	}
+	if session.wherem_value_alt2_go_seq4_label_lit == nil {
+		session.wherem_value_alt2_go_seq4_label_lit = map[int]peg.Result{}
+		session.whatm_value_alt2_go_seq4_label_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt2_go_seq4_label_lit {
+		delete(session.wherem_value_alt2_go_seq4_label_lit, key)
+		delete(session.whatm_value_alt2_go_seq4_label_lit, key)
+	}
+	if session.wherem_value_alt3_try_seq1_lit == nil {
+		session.wherem_value_alt3_try_seq1_lit = map[int]peg.Result{}
+		session.whatm_value_alt3_try_seq1_lit = map[int]string{}
+	}
+	for key := range session.wherem_value_alt3_try_seq1_lit {
+		delete(session.wherem_value_alt3_try_seq1_lit, key)
+		delete(session.whatm_value_alt3_try_seq1_lit, key)
+	}
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *SecondSession) evict(before int, all bool) {
+	session.memos = 0
+	for key := range session.wherem_Doc {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc, key)
+			delete(session.whatm_Doc, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc)
+	for key := range session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+			delete(session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit)
+	for key := range session.wherem_Farthest {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest, key)
+			delete(session.whatm_Farthest, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq1_lit, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq1_lit)
+	for key := range session.wherem_Farthest_alt0_contents_seq1_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt0_contents_seq1_seq2_lit, key)
+			delete(session.whatm_Farthest_alt0_contents_seq1_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt0_contents_seq1_seq2_lit)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit)
+	for key := range session.wherem_Farthest_alt2_contents_seq1_alt2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Farthest_alt2_contents_seq1_alt2_lit, key)
+			delete(session.whatm_Farthest_alt2_contents_seq1_alt2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Farthest_alt2_contents_seq1_alt2_lit)
+	for key := range session.wherem_Items {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Items, key)
+			delete(session.whatm_Items, key)
+		}
+	}
+	session.memos += len(session.wherem_Items)
+	for key := range session.wherem_Letters {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters, key)
+			delete(session.whatm_Letters, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters)
+	for key := range session.wherem_Letters_star_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Letters_star_alt1_lit, key)
+			delete(session.whatm_Letters_star_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Letters_star_alt1_lit)
+	for key := range session.wherem_Nest {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Nest, key)
+			delete(session.whatm_Nest, key)
+		}
+	}
+	session.memos += len(session.wherem_Nest)
+	for key := range session.wherem_Number {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Number, key)
+			delete(session.whatm_Number, key)
+		}
+	}
+	session.memos += len(session.wherem_Number)
+	for key := range session.wherem_Word {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word, key)
+			delete(session.whatm_Word, key)
+		}
+	}
+	session.memos += len(session.wherem_Word)
+	for key := range session.wherem_Word_alt0_go_seq1_and_seq0_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt0_go_seq1_and_seq0_regex, key)
+			delete(session.whatm_Word_alt0_go_seq1_and_seq0_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt0_go_seq1_and_seq0_regex)
+	for key := range session.wherem_Word_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Word_alt1_lit, key)
+			delete(session.whatm_Word_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_Word_alt1_lit)
+	for key := range session.wherem_keyword {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword, key)
+			delete(session.whatm_keyword, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword)
+	for key := range session.wherem_keyword_go_seq0_alt0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq0_alt0_lit, key)
+			delete(session.whatm_keyword_go_seq0_alt0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq0_alt0_lit)
+	for key := range session.wherem_keyword_go_seq0_alt1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq0_alt1_lit, key)
+			delete(session.whatm_keyword_go_seq0_alt1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq0_alt1_lit)
+	for key := range session.wherem_keyword_go_seq1_not {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword_go_seq1_not, key)
+			delete(session.whatm_keyword_go_seq1_not, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword_go_seq1_not)
+	for key := range session.wherem_name {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_name, key)
+			delete(session.whatm_name, key)
+		}
+	}
+	session.memos += len(session.wherem_name)
+	for key := range session.wherem_nest {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_nest, key)
+			delete(session.whatm_nest, key)
+		}
+	}
+	session.memos += len(session.wherem_nest)
+	for key := range session.wherem_number {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number, key)
+			delete(session.whatm_number, key)
+		}
+	}
+	session.memos += len(session.wherem_number)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex)
+	for key := range session.wherem_number_go_seq1_alias_try_contents_seq1_plus {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number_go_seq1_alias_try_contents_seq1_plus, key)
+			delete(session.whatm_number_go_seq1_alias_try_contents_seq1_plus, key)
+		}
+	}
+	session.memos += len(session.wherem_number_go_seq1_alias_try_contents_seq1_plus)
+	for key := range session.wherem_space {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_space, key)
+			delete(session.whatm_space, key)
+		}
+	}
+	session.memos += len(session.wherem_space)
+	for key := range session.wherem_statement {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement, key)
+			delete(session.whatm_statement, key)
+		}
+	}
+	session.memos += len(session.wherem_statement)
+	for key := range session.wherem_statement_alt0_go_seq3_cut {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_statement_alt0_go_seq3_cut, key)
+			delete(session.whatm_statement_alt0_go_seq3_cut, key)
+		}
+	}
+	session.memos += len(session.wherem_statement_alt0_go_seq3_cut)
+	for key := range session.wherem_value {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value, key)
+			delete(session.whatm_value, key)
+		}
+	}
+	session.memos += len(session.wherem_value)
+	for key := range session.wherem_value_alt {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt, key)
+			delete(session.whatm_value_alt, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt)
+	for key := range session.wherem_value_alt2_go_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq1_lit, key)
+			delete(session.whatm_value_alt2_go_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq1_lit)
+	for key := range session.wherem_value_alt2_go_seq4_label_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt2_go_seq4_label_lit, key)
+			delete(session.whatm_value_alt2_go_seq4_label_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt2_go_seq4_label_lit)
+	for key := range session.wherem_value_alt3_try_seq1_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_value_alt3_try_seq1_lit, key)
+			delete(session.whatm_value_alt3_try_seq1_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_value_alt3_try_seq1_lit)
+}
+
+// Doc parses the whole input as Doc. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Doc() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Doc(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// DocPrefix parses as much of the input as Doc matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Doc.
+func (session *SecondSession) DocPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Doc(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Farthest parses the whole input as Farthest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Farthest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Farthest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// FarthestPrefix parses as much of the input as Farthest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Farthest.
+func (session *SecondSession) FarthestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Farthest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Items parses the whole input as Items. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Items() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Items(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ItemsPrefix parses as much of the input as Items matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Items.
+func (session *SecondSession) ItemsPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Items(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Letters parses the whole input as Letters. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Letters() (result []string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Letters(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// LettersPrefix parses as much of the input as Letters matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Letters.
+func (session *SecondSession) LettersPrefix() (result []string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Letters(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Nest parses the whole input as Nest. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Nest() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Nest(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NestPrefix parses as much of the input as Nest matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Nest.
+func (session *SecondSession) NestPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Nest(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Number parses the whole input as Number. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Number() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Number(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// NumberPrefix parses as much of the input as Number matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Number.
+func (session *SecondSession) NumberPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Number(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+// Word parses the whole input as Word. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *SecondSession) Word() (result string, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Word(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// WordPrefix parses as much of the input as Word matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Word.
+func (session *SecondSession) WordPrefix() (result string, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Word(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+var secondResourcem_Letters_star_alt0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:b)")
+var secondResourcem_Word_alt0_go_seq1_and_seq0_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z])")
+var secondResourcem_Word_alt0_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z]+)")
+var secondResourcem_keyword_go_seq1_not_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z0-9])")
+var secondResourcem_name_alias_go_seq2_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[a-z][a-z0-9]*)")
+var secondResourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[0-9])")
+var secondResourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:e[0-9]+)")
+var secondResourcem_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[ \\t\\r\\n]*)")
+
+// Below is the internal generated parse structure.
+// It's not very efficient right now, but is accomplishes parsing in linear time.
+
+// limiter keeps track of a session's use of its limits.
+type secondLimiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
+}
+
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *secondLimiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
+}
+
+// start is called before parsing an input of the given size.
+func (l *secondLimiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
+	}
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
+	}
+	return nil
+}
+
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *SecondSession) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *secondLimiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
+}
+
+// leave is called as each node ends.
+func (l *secondLimiter) leave() {
+	l.depth--
+}
+
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *secondLimiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *secondLimiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *secondLimiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *secondLimiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *SecondSession) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type secondBuffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *secondBuffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *secondBuffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *secondBuffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
+	}
+	return here
+}
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *SecondSession) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
+	}
+	return session.fill(here, to)
+}
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *SecondSession) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
+	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *SecondSession) runes(here int) io.RuneReader {
+	return &secondRuneReader{session: session, start: here, here: here}
+}
+
+type secondRuneReader struct {
+	session *SecondSession
+	start   int
+	here    int
+}
+
+func (r *secondRuneReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *SecondSession) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *SecondSession) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *SecondSession) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *SecondSession) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *secondLimiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
+}
+
+func (session *SecondSession) m_Doc(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Doc[here]; ok {
+		return result, session.whatm_Doc[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc[key]; ok {
+			return result, session.whatm_Doc[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc(here)
+	session.depth--
+	session.wherem_Doc[key] = result
+	session.whatm_Doc[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Doc
+func (session *SecondSession) dm_Doc(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 []string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 []string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, []string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := []string{}
+				var recovered []*peg.ParseError
+				for {
+					next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						check, value := func(here int) (peg.Result, struct {
+							V0 string
+							V1 string
+							V2 string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := session.m_statement(here)
+								if check.Ok || session.failures.Silent != 0 {
+									return check, value
+								}
+								recovered := session.failure(check)
+								session.failures.Clear()
+								at := here
+								for session.available(here, at+1) {
+									session.failures.Silent++
+									sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+									session.failures.Silent--
+									if sync.Ok {
+										break
+									}
+									at = session.next(at)
+								}
+								var placeholder string = "?"
+								return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_space(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if !check.Ok {
+							var zero string
+							return check, zero
+						}
+						answer := func(arg struct {
+							V0 string
+							V1 string
+							V2 string
+						}) string {
+							return arg.V0
+						}(value)
+						return check, answer
+					}(here)
+					if next.Fatal {
+						return next, nil
+					}
+					if !next.Ok {
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					if next.At == here {
+						session.failures.Discard(next.Recovered)
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					here = next.At
+					session.advance(mark, here)
+					recovered = append(recovered, next.Recovered...)
+					result = append(result, value)
+				}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero []string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 []string
+			V1 string
+		}) []string {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *SecondSession) m_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[here]; ok {
+		return result, session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[key]; ok {
+			return result, session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Doc_go_seq0_star_go_seq0_recover1_lit(here)
+	session.depth--
+	session.wherem_Doc_go_seq0_star_go_seq0_recover1_lit[key] = result
+	session.whatm_Doc_go_seq0_star_go_seq0_recover1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ";"
+func (session *SecondSession) dm_Doc_go_seq0_star_go_seq0_recover1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ";" {
+		return session.failures.Fail(here, peg.Expected{Token: ";"}), ""
+	}
+	return peg.Success(here + 1), ";"
+}
+
+func (session *SecondSession) m_Farthest(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest[here]; ok {
+		return result, session.whatm_Farthest[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest[key]; ok {
+			return result, session.whatm_Farthest[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest(here)
+	session.depth--
+	session.wherem_Farthest[key] = result
+	session.whatm_Farthest[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Farthest
+func (session *SecondSession) dm_Farthest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+					V2 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+						V2 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "1" {
+						return session.failures.Fail(here, peg.Expected{Token: "1"}), ""
+					}
+					return peg.Success(here + 1), "1"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				if next, value := session.m_Farthest_alt0_contents_seq1_seq(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "2" {
+						return session.failures.Fail(here, peg.Expected{Token: "2"}), ""
+					}
+					return peg.Success(here + 1), "2"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "3" {
+						return session.failures.Fail(here, peg.Expected{Token: "3"}), ""
+					}
+					return peg.Success(here + 1), "3"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := func(here int) (peg.Result, struct {
+							V0 string
+							V1 string
+							V2 string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "4" {
+						return session.failures.Fail(here, peg.Expected{Token: "4"}), ""
+					}
+					return peg.Success(here + 1), "4"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							check, _ := func(here int) (peg.Result, struct {
+								V0 string
+								V1 string
+							}) {
+								session.enter(here)
+								defer session.leave()
+								result := struct {
+									V0 string
+									V1 string
+								}{}
+								var recovered []*peg.ParseError
+								if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+									here = next.At
+									recovered = append(recovered, next.Recovered...)
+									result.V0 = value
+								} else {
+									return next, struct {
+										V0 string
+										V1 string
+									}{}
+								}
+								if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+									here = next.At
+									recovered = append(recovered, next.Recovered...)
+									result.V1 = value
+								} else {
+									return next, struct {
+										V0 string
+										V1 string
+									}{}
+								}
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}(here)
+							if check.Ok {
+								return check, string(session.slice(here, check.At))
+							}
+							return check, ""
+
+						}(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Farthest_alt2_contents_seq1_alt2_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "5" {
+						return session.failures.Fail(here, peg.Expected{Token: "5"}), ""
+					}
+					return peg.Success(here + 1), "5"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+					if next, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							failed := peg.Result{At: here}
+
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							var zero string
+							return failed, zero
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						if next, value := session.m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero struct {
+						V0 string
+						V1 string
+					}
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 []string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 []string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "6" {
+						return session.failures.Fail(here, peg.Expected{Token: "6"}), ""
+					}
+					return peg.Success(here + 1), "6"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 []string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 []string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 []string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, []string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						result := []string{}
+						var recovered []*peg.ParseError
+						for {
+							next, value := session.m_Letters_star_alt1_lit(here)
+							if next.Fatal {
+								return next, nil
+							}
+							if !next.Ok {
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}
+							if next.At == here {
+								session.failures.Discard(next.Recovered)
+								return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+							}
+							here = next.At
+							session.advance(mark, here)
+							recovered = append(recovered, next.Recovered...)
+							result = append(result, value)
+						}
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 []string
+							V1 string
+						}{}
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 []string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 []string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 string
+					V1 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 string
+						V1 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "7" {
+						return session.failures.Fail(here, peg.Expected{Token: "7"}), ""
+					}
+					return peg.Success(here + 1), "7"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						failed := peg.Result{At: here}
+
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							check, _ := session.m_Farthest_alt0_contents_seq1_seq(here)
+							if check.Ok {
+								return check, string(session.slice(here, check.At))
+							}
+							return check, ""
+
+						}(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+							return next, value
+						} else {
+							failed = peg.Farthest(failed, next)
+						}
+						var zero string
+						return failed, zero
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+1) || string(session.slice(here, here+1)) != "z" {
+							return session.failures.Fail(here, peg.Expected{Token: "z"}), ""
+						}
+						return peg.Success(here + 1), "z"
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 string
+							V1 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct {
+					V0 struct{}
+					V1 string
+					V2 string
+				}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct {
+						V0 struct{}
+						V1 string
+						V2 string
+					}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "8" {
+						return session.failures.Fail(here, peg.Expected{Token: "8"}), ""
+					}
+					return peg.Success(here + 1), "8"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 struct{}
+					V1 string
+					V2 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 struct{}
+						V1 string
+						V2 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := func(here int) (peg.Result, struct{}) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						session.failures.Silent++
+						check, _ := session.m_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+						session.failures.Silent--
+						if !check.Ok {
+							return peg.Success(here), struct{}{}
+						}
+						return session.failures.Fail(here, peg.Exclude{Message: "\"a\" then \"b\""}), struct{}{}
+					}(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V2 = value
+					} else {
+						return next, struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct {
+							V0 struct{}
+							V1 string
+							V2 string
+						}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "9" {
+						return session.failures.Fail(here, peg.Expected{Token: "9"}), ""
+					}
+					return peg.Success(here + 1), "9"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+2) || string(session.slice(here, here+2)) != "ab" {
+							return session.failures.Fail(here, peg.Expected{Token: "ab"}), ""
+						}
+						return peg.Success(here + 2), "ab"
+					}(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero string
+					return failed, zero
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *SecondSession) m_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "b" "c"
+func (session *SecondSession) dm_Farthest_alt0_contents_seq1_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+	V2 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+		V2 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq2_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V2 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+			V2 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *SecondSession) m_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq1_lit[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq1_lit[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq1_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq1_lit[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "b"
+func (session *SecondSession) dm_Farthest_alt0_contents_seq1_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "b" {
+		return session.failures.Fail(here, peg.Expected{Token: "b"}), ""
+	}
+	return peg.Success(here + 1), "b"
+}
+
+func (session *SecondSession) m_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt0_contents_seq1_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt0_contents_seq1_seq2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt0_contents_seq1_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt0_contents_seq1_seq2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt0_contents_seq1_seq2_lit[key] = result
+	session.whatm_Farthest_alt0_contents_seq1_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "c"
+func (session *SecondSession) dm_Farthest_alt0_contents_seq1_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "c" {
+		return session.failures.Fail(here, peg.Expected{Token: "c"}), ""
+	}
+	return peg.Success(here + 1), "c"
+}
+
+func (session *SecondSession) m_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt0_contents_seq[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt0_contents_seq[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a" "b"
+func (session *SecondSession) dm_Farthest_alt2_contents_seq1_alt0_contents_seq(here int) (peg.Result, struct {
+	V0 string
+	V1 string
+}) {
+	result := struct {
+		V0 string
+		V1 string
+	}{}
+	var recovered []*peg.ParseError
+	if next, value := session.m_Letters_star_alt1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V0 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	if next, value := session.m_Farthest_alt0_contents_seq1_seq1_lit(here); next.Ok {
+		here = next.At
+		recovered = append(recovered, next.Recovered...)
+		result.V1 = value
+	} else {
+		return next, struct {
+			V0 string
+			V1 string
+		}{}
+	}
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+}
+
+func (session *SecondSession) m_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "d"
+func (session *SecondSession) dm_Farthest_alt2_contents_seq1_alt1_contents_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "d" {
+		return session.failures.Fail(here, peg.Expected{Token: "d"}), ""
+	}
+	return peg.Success(here + 1), "d"
+}
+
+func (session *SecondSession) m_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt2_lit[here]; ok {
+		return result, session.whatm_Farthest_alt2_contents_seq1_alt2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Farthest_alt2_contents_seq1_alt2_lit[key]; ok {
+			return result, session.whatm_Farthest_alt2_contents_seq1_alt2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Farthest_alt2_contents_seq1_alt2_lit(here)
+	session.depth--
+	session.wherem_Farthest_alt2_contents_seq1_alt2_lit[key] = result
+	session.whatm_Farthest_alt2_contents_seq1_alt2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "e"
+func (session *SecondSession) dm_Farthest_alt2_contents_seq1_alt2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "e" {
+		return session.failures.Fail(here, peg.Expected{Token: "e"}), ""
+	}
+	return peg.Success(here + 1), "e"
+}
+
+func (session *SecondSession) m_Items(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Items[here]; ok {
+		return result, session.whatm_Items[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Items[key]; ok {
+			return result, session.whatm_Items[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Items(here)
+	session.depth--
+	session.wherem_Items[key] = result
+	session.whatm_Items[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Items
+func (session *SecondSession) dm_Items(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		result := []string{}
+		var recovered []*peg.ParseError
+		for {
+			next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				check, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					check, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_name(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						if next, value := session.m_space(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						if next, value := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					if !check.Ok {
+						var zero string
+						return check, zero
+					}
+					answer := func(arg struct {
+						V0 string
+						V1 string
+						V2 string
+					}) string {
+						return arg.V0
+					}(value)
+					return check, answer
+				}(here)
+				if check.Ok || session.failures.Silent != 0 {
+					return check, value
+				}
+				recovered := session.failure(check)
+				session.failures.Clear()
+				at := here
+				for session.available(here, at+1) {
+					session.failures.Silent++
+					sync, _ := session.m_Doc_go_seq0_star_go_seq0_recover1_lit(at)
+					session.failures.Silent--
+					if sync.Ok {
+						break
+					}
+					at = session.next(at)
+				}
+				var placeholder string = "?"
+				return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder
+			}(here)
+			if next.Fatal {
+				return next, nil
+			}
+			if !next.Ok {
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			if next.At == here {
+				session.failures.Discard(next.Recovered)
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			here = next.At
+			session.advance(mark, here)
+			recovered = append(recovered, next.Recovered...)
+			result = append(result, value)
+		}
+	}(here)
+}
+
+func (session *SecondSession) m_Letters(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_Letters[here]; ok {
+		return result, session.whatm_Letters[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters[key]; ok {
+			return result, session.whatm_Letters[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters(here)
+	session.depth--
+	session.wherem_Letters[key] = result
+	session.whatm_Letters[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Letters
+func (session *SecondSession) dm_Letters(here int) (peg.Result, []string) {
+	return func(here int) (peg.Result, []string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		result := []string{}
+		var recovered []*peg.ParseError
+		for {
+			next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = secondResourcem_Letters_star_alt0_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = secondResourcem_Letters_star_alt0_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "b"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := session.m_Letters_star_alt1_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here)
+			if next.Fatal {
+				return next, nil
+			}
+			if !next.Ok {
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			if next.At == here {
+				session.failures.Discard(next.Recovered)
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}
+			here = next.At
+			session.advance(mark, here)
+			recovered = append(recovered, next.Recovered...)
+			result = append(result, value)
+		}
+	}(here)
+}
+
+func (session *SecondSession) m_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Letters_star_alt1_lit[here]; ok {
+		return result, session.whatm_Letters_star_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Letters_star_alt1_lit[key]; ok {
+			return result, session.whatm_Letters_star_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Letters_star_alt1_lit(here)
+	session.depth--
+	session.wherem_Letters_star_alt1_lit[key] = result
+	session.whatm_Letters_star_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "a"
+func (session *SecondSession) dm_Letters_star_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "a" {
+		return session.failures.Fail(here, peg.Expected{Token: "a"}), ""
+	}
+	return peg.Success(here + 1), "a"
+}
+
+func (session *SecondSession) m_Nest(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Nest[here]; ok {
+		return result, session.whatm_Nest[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Nest[key]; ok {
+			return result, session.whatm_Nest[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Nest(here)
+	session.depth--
+	session.wherem_Nest[key] = result
+	session.whatm_Nest[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Nest
+func (session *SecondSession) dm_Nest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 struct{}
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 struct{}
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, struct{}) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				session.failures.Silent++
+				check, _ := func(here int) (peg.Result, struct {
+					V0 string
+					V1 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					result := struct {
+						V0 string
+						V1 string
+					}{}
+					var recovered []*peg.ParseError
+					if next, value := session.m_nest(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V0 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+						here = next.At
+						recovered = append(recovered, next.Recovered...)
+						result.V1 = value
+					} else {
+						return next, struct {
+							V0 string
+							V1 string
+						}{}
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}(here)
+				session.failures.Silent--
+				if !check.Ok {
+					return peg.Success(here), struct{}{}
+				}
+				return session.failures.Fail(here, peg.Exclude{Message: "nest then \"!\""}), struct{}{}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 struct{}
+					V1 string
+				}{}
+			}
+			if next, value := session.m_nest(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 struct{}
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 struct{}
+			V1 string
+		}) string {
+			return arg.V1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *SecondSession) m_Number(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Number[here]; ok {
+		return result, session.whatm_Number[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Number[key]; ok {
+			return result, session.whatm_Number[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Number(here)
+	session.depth--
+	session.wherem_Number[key] = result
+	session.whatm_Number[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Number
+func (session *SecondSession) dm_Number(here int) (peg.Result, string) {
+	return session.m_number(here)
+}
+
+func (session *SecondSession) m_Word(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word[here]; ok {
+		return result, session.whatm_Word[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word[key]; ok {
+			return result, session.whatm_Word[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word(here)
+	session.depth--
+	session.wherem_Word[key] = result
+	session.whatm_Word[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Word
+func (session *SecondSession) dm_Word(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 struct{}
+				V1 struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 struct{}
+					V1 struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, struct{}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					session.failures.Silent++
+					check, _ := func(here int) (peg.Result, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							if !session.available(here, here+2) || string(session.slice(here, here+2)) != "if" {
+								return session.failures.Fail(here, peg.Expected{Token: "if"}), ""
+							}
+							return peg.Success(here + 2), "if"
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							next.Fatal = true
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					session.failures.Silent--
+					if !check.Ok {
+						return peg.Success(here), struct{}{}
+					}
+					return session.failures.Fail(here, peg.Exclude{Message: "\"if\" then \"(\""}), struct{}{}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					check, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						if next, value := session.m_Word_alt0_go_seq1_and_seq0_regex(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							next.Fatal = true
+							return next, struct {
+								V0 string
+								V1 struct{}
+								V2 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					if !check.Ok {
+						check.Fatal = false
+						var zero struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						return check, zero
+					}
+					return peg.Success(here), value
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = secondResourcem_Word_alt0_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = secondResourcem_Word_alt0_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]+"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 struct{}
+						V1 struct {
+							V0 string
+							V1 struct{}
+							V2 string
+						}
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 struct{}
+				V1 struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}
+				V2 string
+			}) string {
+				return arg.V2
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *SecondSession) m_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt0_go_seq1_and_seq0_regex[here]; ok {
+		return result, session.whatm_Word_alt0_go_seq1_and_seq0_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt0_go_seq1_and_seq0_regex[key]; ok {
+			return result, session.whatm_Word_alt0_go_seq1_and_seq0_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt0_go_seq1_and_seq0_regex(here)
+	session.depth--
+	session.wherem_Word_alt0_go_seq1_and_seq0_regex[key] = result
+	session.whatm_Word_alt0_go_seq1_and_seq0_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[a-z]"
+func (session *SecondSession) dm_Word_alt0_go_seq1_and_seq0_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = secondResourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = secondResourcem_Word_alt0_go_seq1_and_seq0_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *SecondSession) m_Word_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_Word_alt1_lit[here]; ok {
+		return result, session.whatm_Word_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Word_alt1_lit[key]; ok {
+			return result, session.whatm_Word_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Word_alt1_lit(here)
+	session.depth--
+	session.wherem_Word_alt1_lit[key] = result
+	session.whatm_Word_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "x"
+func (session *SecondSession) dm_Word_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "x" {
+		return session.failures.Fail(here, peg.Expected{Token: "x"}), ""
+	}
+	return peg.Success(here + 1), "x"
+}
+
+func (session *SecondSession) m_keyword(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword[here]; ok {
+		return result, session.whatm_keyword[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword[key]; ok {
+			return result, session.whatm_keyword[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword(here)
+	session.depth--
+	session.wherem_keyword[key] = result
+	session.whatm_keyword[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root keyword
+func (session *SecondSession) dm_keyword(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 struct{}
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 struct{}
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 struct{}
+				}{}
+			}
+			if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 struct{}
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 struct{}
+		}) string {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *SecondSession) m_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq0_alt0_lit[here]; ok {
+		return result, session.whatm_keyword_go_seq0_alt0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq0_alt0_lit[key]; ok {
+			return result, session.whatm_keyword_go_seq0_alt0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt0_lit(here)
+	session.depth--
+	session.wherem_keyword_go_seq0_alt0_lit[key] = result
+	session.whatm_keyword_go_seq0_alt0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "let"
+func (session *SecondSession) dm_keyword_go_seq0_alt0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+3) || string(session.slice(here, here+3)) != "let" {
+		return session.failures.Fail(here, peg.Expected{Token: "let"}), ""
+	}
+	return peg.Success(here + 3), "let"
+}
+
+func (session *SecondSession) m_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_keyword_go_seq0_alt1_lit[here]; ok {
+		return result, session.whatm_keyword_go_seq0_alt1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq0_alt1_lit[key]; ok {
+			return result, session.whatm_keyword_go_seq0_alt1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq0_alt1_lit(here)
+	session.depth--
+	session.wherem_keyword_go_seq0_alt1_lit[key] = result
+	session.whatm_keyword_go_seq0_alt1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "print"
+func (session *SecondSession) dm_keyword_go_seq0_alt1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+5) || string(session.slice(here, here+5)) != "print" {
+		return session.failures.Fail(here, peg.Expected{Token: "print"}), ""
+	}
+	return peg.Success(here + 5), "print"
+}
+
+func (session *SecondSession) m_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_keyword_go_seq1_not[here]; ok {
+		return result, session.whatm_keyword_go_seq1_not[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword_go_seq1_not[key]; ok {
+			return result, session.whatm_keyword_go_seq1_not[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword_go_seq1_not(here)
+	session.depth--
+	session.wherem_keyword_go_seq1_not[key] = result
+	session.whatm_keyword_go_seq1_not[key] = value
+	session.count(here)
+	return result, value
+}
+
+// not (regex "[a-z0-9]")
+func (session *SecondSession) dm_keyword_go_seq1_not(here int) (peg.Result, struct{}) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	session.failures.Silent++
+	check, _ := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = secondResourcem_keyword_go_seq1_not_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = secondResourcem_keyword_go_seq1_not_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z0-9]"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
+	session.failures.Silent--
+	if !check.Ok {
+		return peg.Success(here), struct{}{}
+	}
+	return session.failures.Fail(here, peg.Exclude{Message: "text matching `[a-z0-9]`"}), struct{}{}
+}
+
+func (session *SecondSession) m_name(here int) (peg.Result, string) {
+	if result, ok := session.wherem_name[here]; ok {
+		return result, session.whatm_name[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_name[key]; ok {
+			return result, session.whatm_name[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_name(here)
+	session.depth--
+	session.wherem_name[key] = result
+	session.whatm_name[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root name
+func (session *SecondSession) dm_name(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.failures.Mark()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct{}
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, struct{}) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					session.failures.Silent++
+					check, _ := session.m_keyword(here)
+					session.failures.Silent--
+					if !check.Ok {
+						return peg.Success(here), struct{}{}
+					}
+					return session.failures.Fail(here, peg.Exclude{Message: "keyword"}), struct{}{}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = secondResourcem_name_alias_go_seq2_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = secondResourcem_name_alias_go_seq2_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[a-z][a-z0-9]*"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 struct{}
+				V2 string
+			}) string {
+				return arg.V2
+			}(value)
+			return check, answer
+		}(here)
+		if !check.Ok {
+			return session.failures.Name(mark, session.named(here, check.At), check, "name"), value
+		}
+		return check, value
+	}(here)
+}
+
+func (session *SecondSession) m_nest(here int) (peg.Result, string) {
+	if result, ok := session.wherem_nest[here]; ok {
+		return result, session.whatm_nest[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_nest[key]; ok {
+			return result, session.whatm_nest[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_nest(here)
+	session.depth--
+	session.wherem_nest[key] = result
+	session.whatm_nest[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root nest
+func (session *SecondSession) dm_nest(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_nest(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_value_alt2_go_seq4_label_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 string
+			}) string {
+				return arg.V1
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_nest(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "]" {
+						return session.failures.Fail(here, peg.Expected{Token: "]"}), ""
+					}
+					return peg.Success(here + 1), "]"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 string
+			}) string {
+				return arg.V1
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_Word_alt1_lit(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *SecondSession) m_number(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number[here]; ok {
+		return result, session.whatm_number[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number[key]; ok {
+			return result, session.whatm_number[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number(here)
+	session.depth--
+	session.wherem_number[key] = result
+	session.whatm_number[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root number
+func (session *SecondSession) dm_number(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 float64
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 float64
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 float64
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, float64) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.failures.Mark()
+				check, value := func(here int) (peg.Result, float64) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.failures.Save()
+					check, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						mark := session.hold(here)
+						defer session.release(mark)
+						check, _ := func(here int) (peg.Result, struct {
+							V0 string
+							V1 []string
+							V2 *struct {
+								V0 string
+								V1 []string
+							}
+							V3 *string
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 []string
+								V2 *struct {
+									V0 string
+									V1 []string
+								}
+								V3 *string
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+								if !check.Ok {
+									check.Fatal = false
+									var zero string
+									return check, zero
+								}
+								return peg.Success(here), value
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := func(here int) (peg.Result, *struct {
+								V0 string
+								V1 []string
+							}) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := func(here int) (peg.Result, struct {
+									V0 string
+									V1 []string
+								}) {
+									session.enter(here)
+									defer session.leave()
+									result := struct {
+										V0 string
+										V1 []string
+									}{}
+									var recovered []*peg.ParseError
+									if next, value := func(here int) (peg.Result, string) {
+										session.enter(here)
+										defer session.leave()
+										if !session.available(here, here+1) || string(session.slice(here, here+1)) != "." {
+											return session.failures.Fail(here, peg.Expected{Token: "."}), ""
+										}
+										return peg.Success(here + 1), "."
+									}(here); next.Ok {
+										here = next.At
+										recovered = append(recovered, next.Recovered...)
+										result.V0 = value
+									} else {
+										return next, struct {
+											V0 string
+											V1 []string
+										}{}
+									}
+									if next, value := session.m_number_go_seq1_alias_try_contents_seq1_plus(here); next.Ok {
+										here = next.At
+										recovered = append(recovered, next.Recovered...)
+										result.V1 = value
+									} else {
+										return next, struct {
+											V0 string
+											V1 []string
+										}{}
+									}
+									return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+								}(here)
+								if check.Ok {
+									return check, &value
+								}
+								if check.Fatal {
+									return check, nil
+								}
+								return peg.Success(here), nil
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							if next, value := func(here int) (peg.Result, *string) {
+								session.enter(here)
+								defer session.leave()
+								mark := session.hold(here)
+								defer session.release(mark)
+								check, value := func(here int) (peg.Result, string) {
+									session.enter(here)
+									defer session.leave()
+									var match []int
+									if session.source == nil {
+										match = secondResourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindIndex(session.slice(here, session.end()))
+									} else {
+										match = secondResourcem_number_go_seq1_alias_try_contents_seq3_opt_regexRegex.FindReaderIndex(session.runes(here))
+									}
+									if match == nil {
+										return session.failures.Fail(here, peg.ExpectedPattern{Regex: "e[0-9]+"}), ""
+									}
+									end := match[1]
+									return peg.Success(here + end), string(session.slice(here, here+end))
+
+								}(here)
+								if check.Ok {
+									return check, &value
+								}
+								if check.Fatal {
+									return check, nil
+								}
+								return peg.Success(here), nil
+
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V3 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 []string
+									V2 *struct {
+										V0 string
+										V1 []string
+									}
+									V3 *string
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if check.Ok {
+							return check, string(session.slice(here, check.At))
+						}
+						return check, ""
+
+					}(here)
+					if !check.Ok {
+						var zero float64
+						return check, zero
+					}
+					answer, err := func(arg string) (float64, error) {
+						return strconv.ParseFloat(arg, 64)
+					}(value)
+					if err != nil {
+						return session.failures.Reject(mark, here, check.At, peg.Invalid{Err: err}), answer
+					}
+					return check, answer
+				}(here)
+				if !check.Ok {
+					return session.failures.Name(mark, session.named(here, check.At), check, "number"), value
+				}
+				return check, value
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 float64
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 float64
+		}) string {
+			return strconv.FormatFloat(arg.V1, 'g', -1, 64)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *SecondSession) m_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq0_and_regex[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq0_and_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// regex "[0-9]"
+func (session *SecondSession) dm_number_go_seq1_alias_try_contents_seq0_and_regex(here int) (peg.Result, string) {
+	var match []int
+	if session.source == nil {
+		match = secondResourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindIndex(session.slice(here, session.end()))
+	} else {
+		match = secondResourcem_number_go_seq1_alias_try_contents_seq0_and_regexRegex.FindReaderIndex(session.runes(here))
+	}
+	if match == nil {
+		return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[0-9]"}), ""
+	}
+	end := match[1]
+	return peg.Success(here + end), string(session.slice(here, here+end))
+
+}
+
+func (session *SecondSession) m_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq1_plus[here]; ok {
+		return result, session.whatm_number_go_seq1_alias_try_contents_seq1_plus[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number_go_seq1_alias_try_contents_seq1_plus[key]; ok {
+			return result, session.whatm_number_go_seq1_alias_try_contents_seq1_plus[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number_go_seq1_alias_try_contents_seq1_plus(here)
+	session.depth--
+	session.wherem_number_go_seq1_alias_try_contents_seq1_plus[key] = result
+	session.whatm_number_go_seq1_alias_try_contents_seq1_plus[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (regex "[0-9]")+
+func (session *SecondSession) dm_number_go_seq1_alias_try_contents_seq1_plus(here int) (peg.Result, []string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	result := []string{}
+	var recovered []*peg.ParseError
+	for {
+		next, value := session.m_number_go_seq1_alias_try_contents_seq0_and_regex(here)
+		if !next.Ok {
+			if len(result) == 0 || next.Fatal {
+				return next, nil
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		if next.At == here && len(result) != 0 {
+			session.failures.Discard(next.Recovered)
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}
+		here = next.At
+		session.advance(mark, here)
+		recovered = append(recovered, next.Recovered...)
+		result = append(result, value)
+	}
+}
+
+func (session *SecondSession) m_space(here int) (peg.Result, string) {
+	if result, ok := session.wherem_space[here]; ok {
+		return result, session.whatm_space[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_space[key]; ok {
+			return result, session.whatm_space[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_space(here)
+	session.depth--
+	session.wherem_space[key] = result
+	session.whatm_space[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root space
+func (session *SecondSession) dm_space(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = secondResourcem_space_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = secondResourcem_space_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[ \\t\\r\\n]*"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
+}
+
+func (session *SecondSession) m_statement(here int) (peg.Result, string) {
+	if result, ok := session.wherem_statement[here]; ok {
+		return result, session.whatm_statement[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement[key]; ok {
+			return result, session.whatm_statement[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement(here)
+	session.depth--
+	session.wherem_statement[key] = result
+	session.whatm_statement[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root statement
+func (session *SecondSession) dm_statement(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 string
+				V5 string
+				V6 string
+				V7 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				start := here
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 struct{}
+					V4 string
+					V5 string
+					V6 string
+					V7 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq0_alt0_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_name(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V4 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V5 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					check, value := func(here int) (peg.Result, string) {
+						session.enter(here)
+						defer session.leave()
+						if !session.available(here, here+1) || string(session.slice(here, here+1)) != "=" {
+							return session.failures.Fail(here, peg.Expected{Token: "="}), ""
+						}
+						return peg.Success(here + 1), "="
+					}(here)
+					if !check.Ok {
+						return session.failures.Label(here, "missing equals sign", false), value
+					}
+					return check, value
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V6 = value
+				} else {
+					next.Fatal = true
+					next = session.failures.Open(next, start)
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				if next, value := session.m_value(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V7 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 string
+				V5 string
+				V6 string
+				V7 string
+			}) string {
+				return arg.V4 + "=" + arg.V7
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 []string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 struct{}
+					V4 []string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq0_alt1_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_keyword_go_seq1_not(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := session.m_statement_alt0_go_seq3_cut(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, []string) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					result := []string{}
+					var recovered []*peg.ParseError
+					for {
+						next, value := session.m_value(here)
+						if !next.Ok {
+							if len(result) == 0 || next.Fatal {
+								return next, nil
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}
+						if next.At == here && len(result) != 0 {
+							session.failures.Discard(next.Recovered)
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}
+						here = next.At
+						session.advance(mark, here)
+						recovered = append(recovered, next.Recovered...)
+						result = append(result, value)
+					}
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V4 = value
+				} else {
+					next.Fatal = true
+					return next, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 struct{}
+						V4 []string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 struct{}
+				V4 []string
+			}) string {
+				return "print " + strings.Join(arg.V4, " ")
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
+}
+
+func (session *SecondSession) m_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_statement_alt0_go_seq3_cut[here]; ok {
+		return result, session.whatm_statement_alt0_go_seq3_cut[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_statement_alt0_go_seq3_cut[key]; ok {
+			return result, session.whatm_statement_alt0_go_seq3_cut[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_statement_alt0_go_seq3_cut(here)
+	session.depth--
+	session.wherem_statement_alt0_go_seq3_cut[key] = result
+	session.whatm_statement_alt0_go_seq3_cut[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ~
+func (session *SecondSession) dm_statement_alt0_go_seq3_cut(here int) (peg.Result, struct{}) {
+	return peg.Success(here), struct{}{}
+}
+
+func (session *SecondSession) m_value(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value[here]; ok {
+		return result, session.whatm_value[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value[key]; ok {
+			return result, session.whatm_value[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value(here)
+	session.depth--
+	session.wherem_value[key] = result
+	session.whatm_value[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root value
+func (session *SecondSession) dm_value(here int) (peg.Result, string) {
+	return session.m_value_alt(here)
+}
+
+func (session *SecondSession) m_value_alt(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt[here]; ok {
+		return result, session.whatm_value_alt[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt[key]; ok {
+			return result, session.whatm_value_alt[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt(here)
+	session.depth--
+	session.wherem_value_alt[key] = result
+	session.whatm_value_alt[key] = value
+	session.count(here)
+	return result, value
+}
+
+// (root number / root name / root space "(" root value root space ")"^^"unclosed parenthesis" go string { "(" + arg.V2 + ")" } / root space "!" try! string { "", errors.New("values can't be shouted") })
+func (session *SecondSession) dm_value_alt(here int) (peg.Result, string) {
+	mark := session.hold(here)
+	defer session.release(mark)
+	failed := peg.Result{At: here}
+
+	if next, value := session.m_number(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := session.m_name(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			start := here
+			mark := session.hold(here)
+			defer session.release(mark)
+			result := struct {
+				V0 string
+				V1 string
+				V2 string
+				V3 string
+				V4 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_value_alt2_go_seq1_lit(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_value(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V3 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				check, value := session.m_value_alt2_go_seq4_label_lit(here)
+				if !check.Ok {
+					return session.failures.Label(here, "unclosed parenthesis", true), value
+				}
+				return check, value
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V4 = value
+			} else {
+				next = session.failures.Open(next, start)
+				return next, struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+		}) string {
+			return "(" + arg.V2 + ")"
+		}(value)
+		return check, answer
+	}(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	if next, value := func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			if next, value := session.m_value_alt3_try_seq1_lit(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer, err := func(arg struct {
+			V0 string
+			V1 string
+		}) (string, error) {
+			return "", errors.New("values can't be shouted")
+		}(value)
+		if err != nil {
+			return session.failures.Abort(here, peg.Invalid{Err: err}), answer
+		}
+		return check, answer
+	}(here); next.Ok || next.Fatal {
+		return next, value
+	} else {
+		failed = peg.Farthest(failed, next)
+	}
+	var zero string
+	return failed, zero
+}
+
+func (session *SecondSession) m_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go_seq1_lit[here]; ok {
+		return result, session.whatm_value_alt2_go_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq1_lit[key]; ok {
+			return result, session.whatm_value_alt2_go_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq1_lit(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq1_lit[key] = result
+	session.whatm_value_alt2_go_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "("
+func (session *SecondSession) dm_value_alt2_go_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "(" {
+		return session.failures.Fail(here, peg.Expected{Token: "("}), ""
+	}
+	return peg.Success(here + 1), "("
+}
+
+func (session *SecondSession) m_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt2_go_seq4_label_lit[here]; ok {
+		return result, session.whatm_value_alt2_go_seq4_label_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt2_go_seq4_label_lit[key]; ok {
+			return result, session.whatm_value_alt2_go_seq4_label_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt2_go_seq4_label_lit(here)
+	session.depth--
+	session.wherem_value_alt2_go_seq4_label_lit[key] = result
+	session.whatm_value_alt2_go_seq4_label_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// ")"
+func (session *SecondSession) dm_value_alt2_go_seq4_label_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != ")" {
+		return session.failures.Fail(here, peg.Expected{Token: ")"}), ""
+	}
+	return peg.Success(here + 1), ")"
+}
+
+func (session *SecondSession) m_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_value_alt3_try_seq1_lit[here]; ok {
+		return result, session.whatm_value_alt3_try_seq1_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_value_alt3_try_seq1_lit[key]; ok {
+			return result, session.whatm_value_alt3_try_seq1_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_value_alt3_try_seq1_lit(here)
+	session.depth--
+	session.wherem_value_alt3_try_seq1_lit[key] = result
+	session.whatm_value_alt3_try_seq1_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "!"
+func (session *SecondSession) dm_value_alt3_try_seq1_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "!" {
+		return session.failures.Fail(here, peg.Expected{Token: "!"}), ""
+	}
+	return peg.Success(here + 1), "!"
+}
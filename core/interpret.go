@@ -0,0 +1,336 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// Interpreter parses input with the grammar defined in a State, without
+// generating any code. Its values are generic: literals, regexes and contents
+// produce strings, sequences, stars and pluses produce []interface{}, absent
+// optionals produce nil, and Go nodes call the action registered for their
+// expression with the value of their argument.
+type Interpreter struct {
+	State   *State
+	Actions map[string]func(arg interface{}) interface{}          // Actions for Go nodes and Recover placeholders, by expression
+	Tries   map[string]func(arg interface{}) (interface{}, error) // Actions for Try nodes, by expression
+	regexes map[string]*regexp.Regexp                             // Compiled regexes, by definition ID
+}
+
+// NewInterpreter makes an interpreter for the grammar defined in the state. It
+// fails if a definition isn't one of the node types in this package, or uses a
+// root that isn't defined.
+func NewInterpreter(state *State) (*Interpreter, error) {
+	interpreter := &Interpreter{
+		State:   state,
+		Actions: map[string]func(interface{}) interface{}{},
+		Tries:   map[string]func(interface{}) (interface{}, error){},
+		regexes: map[string]*regexp.Regexp{},
+	}
+	ids := []string{}
+	for id := range state.Definitions {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		definition := state.Definitions[id]
+		if !definition.Root && !interpretable(definition.Node) {
+			return nil, fmt.Errorf("the interpreter can't parse %T nodes, as %s is", definition.Node, id)
+		}
+		for _, used := range definition.Uses {
+			if _, ok := state.Definitions[used]; !ok {
+				return nil, fmt.Errorf("%s uses %s, which is not defined", id, used)
+			}
+		}
+		if node, ok := definition.Node.(Regex); ok {
+			regex, err := regexp.Compile(`^(?:` + node.Regex + `)`)
+			if err != nil {
+				return nil, err
+			}
+			interpreter.regexes[id] = regex
+		}
+	}
+	return interpreter, nil
+}
+
+// interpretable says whether the interpreter can parse the node.
+func interpretable(node Peg) bool {
+	switch node.(type) {
+	case Literal, Sequence, Alternate, Star, Plus, Not, And, Go, Try, Regex, Contents, Alias, Optional, Label, Recover, Cut:
+		return true
+	}
+	return false
+}
+
+// Parse parses the whole input as the given root, with the same results (and
+// the same errors) as the generated parser. It fails with a *runtime.ParseError
+// if the input doesn't match.
+func (interpreter *Interpreter) Parse(root string, input []byte) (interface{}, error) {
+	run, check, value, err := interpreter.start(root, input)
+	if err != nil {
+		return nil, err
+	}
+	if check.Ok && check.At < len(input) {
+		failed := run.failures.Fail(check.At, runtime.ExpectedEnd{})
+		failed.Recovered = check.Recovered
+		check = failed
+	}
+	err = run.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return nil, err
+	}
+	return value, err
+}
+
+// ParsePrefix parses as much of the input as the given root matches, and
+// returns how many bytes that was.
+func (interpreter *Interpreter) ParsePrefix(root string, input []byte) (interface{}, int, error) {
+	run, check, value, err := interpreter.start(root, input)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = run.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return nil, 0, err
+	}
+	return value, check.At, err
+}
+
+// start parses the input as the given root, from its beginning.
+func (interpreter *Interpreter) start(root string, input []byte) (*interpretation, runtime.Result, interface{}, error) {
+	id, ok := interpreter.State.Roots[root]
+	if _, defined := interpreter.State.Definitions[id]; !ok || !defined {
+		return nil, runtime.Result{}, nil, fmt.Errorf("root `%s` is not defined", root)
+	}
+	for _, definition := range interpreter.State.Definitions {
+		if node, ok := definition.Node.(Go); ok && interpreter.Actions[node.Expression] == nil {
+			return nil, runtime.Result{}, nil, fmt.Errorf("no action is registered for `%s`", node.Expression)
+		}
+		if node, ok := definition.Node.(Try); ok && interpreter.Tries[node.Expression] == nil {
+			return nil, runtime.Result{}, nil, fmt.Errorf("no action is registered for `%s`", node.Expression)
+		}
+		if node, ok := definition.Node.(Recover); ok && node.Placeholder != "" && interpreter.Actions[node.Placeholder] == nil {
+			return nil, runtime.Result{}, nil, fmt.Errorf("no action is registered for `%s`", node.Placeholder)
+		}
+	}
+	run := &interpretation{
+		Interpreter: interpreter,
+		input:       input,
+		memo:        map[string]map[int]interpreted{},
+	}
+	check, value := run.parse(id, 0)
+	return run, check, value, nil
+}
+
+// finish reports the errors a parse with the given result recovered from, and
+// its failure, if it failed.
+func (run *interpretation) finish(check runtime.Result) error {
+	if check.Ok {
+		return runtime.Errors(check.Recovered, nil)
+	}
+	return runtime.Errors(check.Recovered, run.failure(check))
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached.
+func (run *interpretation) failure(check runtime.Result) *runtime.ParseError {
+	at, expected := run.failures.FailedAt, run.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	return run.lines.ParseError(runtime.Position{Line: 1, Column: 1}, run.input, at, append([]runtime.Reject{}, expected...))
+}
+
+type interpreted struct {
+	result runtime.Result
+	value  interface{}
+	silent bool
+}
+
+// interpretation is the state of a single parse by an Interpreter.
+type interpretation struct {
+	*Interpreter
+	input    []byte
+	memo     map[string]map[int]interpreted
+	failures runtime.Tracker
+	lines    runtime.Locator
+}
+
+func (run *interpretation) parse(id string, here int) (runtime.Result, interface{}) {
+	// Failures inside a lookahead aren't recorded, so what was parsed in one
+	// must be parsed again if it's needed elsewhere.
+	if entry, ok := run.memo[id][here]; ok && (!entry.silent || run.failures.Silent != 0) {
+		return entry.result, entry.value
+	}
+	result, value := run.evaluate(id, here)
+	if run.memo[id] == nil {
+		run.memo[id] = map[int]interpreted{}
+	}
+	run.memo[id][here] = interpreted{result, value, run.failures.Silent != 0}
+	return result, value
+}
+
+func (run *interpretation) evaluate(id string, here int) (runtime.Result, interface{}) {
+	input := run.input
+	definition := run.State.Definitions[id]
+	if definition.Root {
+		return run.parse(definition.Uses[0], here)
+	}
+	switch node := definition.Node.(type) {
+	case Literal:
+		if here+len(node) > len(input) || string(input[here:here+len(node)]) != string(node) {
+			return run.failures.Fail(here, runtime.Expected{Token: string(node)}), ""
+		}
+		return runtime.Success(here + len(node)), string(node)
+	case Sequence:
+		values := []interface{}{}
+		start, cut := here, false
+		var recovered []*runtime.ParseError
+		for i, child := range definition.Uses {
+			next, value := run.parse(child, here)
+			if !next.Ok {
+				next.Fatal = next.Fatal || cut
+				if _, ok := node[i].(Label); ok && i > 0 {
+					next = run.failures.Open(next, start)
+				}
+				return next, nil
+			}
+			here = next.At
+			values = append(values, value)
+			recovered = append(recovered, next.Recovered...)
+			if _, ok := node[i].(Cut); ok {
+				cut = true
+			}
+		}
+		return runtime.Result{Ok: true, At: here, Recovered: recovered}, values
+	case Alternate:
+		failed := runtime.Result{At: here}
+		for _, child := range definition.Uses {
+			next, value := run.parse(child, here)
+			if next.Ok || next.Fatal {
+				return next, value
+			}
+			failed = runtime.Farthest(failed, next)
+		}
+		return failed, nil
+	case Star, Plus:
+		values := []interface{}{}
+		var recovered []*runtime.ParseError
+		for {
+			next, value := run.parse(definition.Uses[0], here)
+			_, plus := node.(Plus)
+			if !next.Ok && (plus && len(values) == 0 || next.Fatal) {
+				return next, nil
+			}
+			if !next.Ok || next.At == here && (!plus || len(values) != 0) {
+				if next.Ok {
+					run.failures.Discard(next.Recovered)
+				}
+				return runtime.Result{Ok: true, At: here, Recovered: recovered}, values
+			}
+			here = next.At
+			values = append(values, value)
+			recovered = append(recovered, next.Recovered...)
+		}
+	case Not:
+		run.failures.Silent++
+		check, _ := run.parse(definition.Uses[0], here)
+		run.failures.Silent--
+		if !check.Ok {
+			return runtime.Success(here), struct{}{}
+		}
+		return run.failures.Fail(here, runtime.Exclude{Message: describe(node.Argument)}), struct{}{}
+	case And:
+		check, value := run.parse(definition.Uses[0], here)
+		if !check.Ok {
+			check.Fatal = false
+			return check, nil
+		}
+		return runtime.Success(here), value
+	case Go:
+		check, value := run.parse(definition.Uses[0], here)
+		if !check.Ok {
+			return check, nil
+		}
+		return check, run.Actions[node.Expression](value)
+	case Try:
+		mark := run.failures.Save()
+		check, value := run.parse(definition.Uses[0], here)
+		if !check.Ok {
+			return check, nil
+		}
+		answer, err := run.Tries[node.Expression](value)
+		if err == nil {
+			return check, answer
+		}
+		if node.Fatal {
+			return run.failures.Abort(here, runtime.Invalid{Err: err}), answer
+		}
+		return run.failures.Reject(mark, here, check.At, runtime.Invalid{Err: err}), answer
+	case Regex:
+		match := run.regexes[id].FindIndex(input[here:])
+		if match == nil {
+			return run.failures.Fail(here, runtime.ExpectedPattern{Regex: node.Regex}), ""
+		}
+		return runtime.Success(here + match[1]), string(input[here : here+match[1]])
+	case Contents:
+		check, _ := run.parse(definition.Uses[0], here)
+		if check.Ok {
+			return check, string(input[here:check.At])
+		}
+		return check, ""
+	case Alias:
+		mark := run.failures.Mark()
+		check, value := run.parse(definition.Uses[0], here)
+		if !check.Ok {
+			at := here
+			if check.At > here && runtime.Blank(run.input[here:check.At]) {
+				at = check.At
+			}
+			return run.failures.Name(mark, at, check, node.Name), value
+		}
+		return check, value
+	case Optional:
+		check, value := run.parse(definition.Uses[0], here)
+		if check.Ok || check.Fatal {
+			return check, value
+		}
+		return runtime.Success(here), nil
+	case Label:
+		check, value := run.parse(definition.Uses[0], here)
+		if !check.Ok {
+			return run.failures.Label(here, node.Message, node.Opening), value
+		}
+		return check, value
+	case Recover:
+		check, value := run.parse(definition.Uses[0], here)
+		if check.Ok || run.failures.Silent != 0 {
+			return check, value
+		}
+		recovered := run.failure(check)
+		run.failures.Clear()
+		at := here
+		for at < len(input) {
+			run.failures.Silent++
+			sync, _ := run.parse(definition.Uses[1], at)
+			run.failures.Silent--
+			if sync.Ok {
+				break
+			}
+			_, size := utf8.DecodeRune(input[at:])
+			at += size
+		}
+		var placeholder interface{}
+		if node.Placeholder != "" {
+			placeholder = run.Actions[node.Placeholder](nil)
+		}
+		return runtime.Result{Ok: true, At: at, Recovered: []*runtime.ParseError{recovered}}, placeholder
+	case Cut:
+		return runtime.Success(here), struct{}{}
+	}
+	panic(fmt.Sprintf("the interpreter can't parse %T nodes", definition.Node)) // NewInterpreter rejects them
+}
@@ -0,0 +1,210 @@
+package core_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testgrammar"
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// expiring is a context which is done once its Err has been checked a number
+// of times, so that it ends partway through a parse.
+type expiring struct {
+	context.Context
+	checks int
+}
+
+func (ctx *expiring) Err() error {
+	ctx.checks--
+	if ctx.checks < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+// Limits apply to every node, even one inlined into the function for another,
+// so a Star over a long input stops as soon as it should.
+func TestLimitsStopStar(t *testing.T) {
+	input := []byte(strings.Repeat("a", 1<<20))
+	for variant, parser := range parsers {
+		_, err := parser.ParseLettersContext(context.Background(), input, runtime.Limits{MaxSteps: 1000})
+		var limit *runtime.LimitError
+		if !errors.As(err, &limit) || limit.Limit != "steps" || limit.At > 1000 {
+			t.Errorf("%s: with MaxSteps, got %v", variant, err)
+		}
+
+		ctx := &expiring{Context: context.Background(), checks: 2}
+		_, err = parser.ParseLettersContext(ctx, input, runtime.Limits{})
+		if !errors.As(err, &limit) || limit.Limit != "context" || !errors.Is(err, context.Canceled) || limit.At > 10000 {
+			t.Errorf("%s: with a cancelled context, got %v", variant, err)
+		}
+	}
+}
+
+// Each limit stops the parse with a LimitError naming it, before the given
+// offset. Depth counts every node, inlined or not: each level of parentheses
+// nests five (value's Memo and Alternate, and the action and Sequence of its
+// parenthesized alternative, around the next value), so nesting stops within
+// a quarter of MaxDepth levels.
+func TestLimits(t *testing.T) {
+	nested := []byte("print " + strings.Repeat("(", 10000) + "x" + strings.Repeat(")", 10000) + ";")
+	tests := []struct {
+		input  []byte
+		limits runtime.Limits
+		limit  string
+		before int
+	}{
+		{nested, runtime.Limits{MaxDepth: 100}, "depth", len("print ") + 100/4},
+		{nested, runtime.Limits{MaxInput: 1000}, "input", 1001},
+		{document(400), runtime.Limits{MaxMemo: 100}, "memo", 100},
+		{document(400), runtime.Limits{MaxSteps: 100}, "steps", 100},
+	}
+	for _, test := range tests {
+		for variant, parser := range parsers {
+			_, err := parser.ParseDocContext(context.Background(), test.input, test.limits)
+			var limit *runtime.LimitError
+			if !errors.As(err, &limit) || limit.Limit != test.limit || limit.At >= test.before {
+				t.Errorf("%s: with %+v, got %v", variant, test.limits, err)
+			}
+		}
+	}
+}
+
+// A MemoBudget only changes how much of the parse is repeated, never its
+// outcome, even when it is so small that the tables are evicted at every step,
+// and whether or not the input is streamed.
+func TestMemoBudget(t *testing.T) {
+	inputs := [][]byte{
+		document(50),
+		[]byte("let x = 1.25e3; print x (y) (((2)));\n\tprint 3;"),
+		[]byte("let x 1;"), []byte("print ((x) y;"), []byte("let x = 1e999;"),
+		[]byte("print x\n;;"), []byte("let x = 1; ~; print é;"), []byte("print 1 2 3; garbage"),
+	}
+	for _, input := range inputs {
+		for variant, parser := range parsers {
+			want := show(parser.ParseDoc(input))
+			for _, budget := range []int{1, 2, 10} {
+				got := show(parser.ParseDocContext(context.Background(), input, runtime.Limits{MemoBudget: budget}))
+				if got != want {
+					t.Errorf("%s: with a budget of %d, %q gave\n%s\nnot\n%s", variant, budget, input, got, want)
+				}
+				session := sessions[variant](nil)
+				session.ResetReader(bytes.NewReader(input))
+				session.Limit(context.Background(), runtime.Limits{MemoBudget: budget})
+				if got := show(session.Doc()); got != want {
+					t.Errorf("%s: streamed with a budget of %d, %q gave\n%s\nnot\n%s", variant, budget, input, got, want)
+				}
+			}
+		}
+	}
+}
+
+// steps is the fewest MaxSteps that the parse fits in.
+func steps(parse func(limits runtime.Limits) error) int {
+	low, high := 1, 1<<20
+	for low < high {
+		middle := (low + high) / 2
+		var limit *runtime.LimitError
+		if errors.As(parse(runtime.Limits{MaxSteps: middle}), &limit) {
+			low = middle + 1
+		} else {
+			high = middle
+		}
+	}
+	return low
+}
+
+// docSteps is the fewest MaxSteps that the parse of the input as Doc fits in.
+func docSteps(parser parser, input []byte) int {
+	return steps(func(limits runtime.Limits) error {
+		_, err := parser.ParseDocContext(context.Background(), input, limits)
+		return err
+	})
+}
+
+// The parse takes as many steps per byte of a long document as of a short one,
+// so it takes linear time, whatever the processor's caches make of it.
+func TestLinearSteps(t *testing.T) {
+	short, long := document(16), document(256)
+	for variant, parser := range parsers {
+		perShort := float64(docSteps(parser, short)) / float64(len(short))
+		perLong := float64(docSteps(parser, long)) / float64(len(long))
+		if perLong > perShort*1.01 {
+			t.Errorf("%s: %.2f steps per byte of a short document, but %.2f of a long one", variant, perShort, perLong)
+		}
+	}
+}
+
+// Each regex is anchored where it's tried, so one which fails there gives up at
+// once, rather than searching the rest of the input for a match. Letters tries
+// the regex "b" at every "a", so parsing a long run of them before a "b" would
+// take time growing with its square if it searched; instead it takes as long
+// per byte as a short run.
+func TestAnchoredRegex(t *testing.T) {
+	for variant, parser := range parsers {
+		perByte := func(length int) float64 {
+			input := []byte(strings.Repeat("a", length) + "b")
+			start := time.Now()
+			letters, err := parser.ParseLetters(input)
+			elapsed := time.Since(start)
+			if err != nil || len(letters) != length+1 {
+				t.Errorf("%s: %d letters gave %d, %v", variant, len(input), len(letters), err)
+			}
+			return float64(elapsed) / float64(len(input))
+		}
+		if short, long := perByte(1<<16), perByte(1<<20); long > 4*short {
+			t.Errorf("%s: %.0fns per byte of a short run, but %.0fns of a long one", variant, short, long)
+		}
+	}
+}
+
+// nested is "x" in the given number of parentheses, each closed by "]", so that
+// Nest tries closing it by ")" first and backtracks.
+func nested(depth int) []byte {
+	return []byte(strings.Repeat("(", depth) + "x" + strings.Repeat("]", depth))
+}
+
+// What is parsed inside a negative lookahead is memoized too, so Nest backtracks
+// over each level once, rather than twice for each level around it, and takes
+// as many steps per level however deep it goes.
+func TestLookaheadSteps(t *testing.T) {
+	for variant, parser := range parsers {
+		perLevel := func(depth int) float64 {
+			return float64(steps(func(limits runtime.Limits) error {
+				_, err := parser.ParseNestContext(context.Background(), nested(depth), limits)
+				return err
+			})) / float64(depth)
+		}
+		if shallow, deep := perLevel(8), perLevel(64); deep > shallow*1.01 {
+			t.Errorf("%s: %.2f steps per level of a shallow nest, but %.2f of a deep one", variant, shallow, deep)
+		}
+	}
+}
+
+// The interpreter has no limits to count its steps, so it must parse a nest
+// deep enough to take hours if each level were backtracked over twice for each
+// level around it, within seconds.
+func TestLookaheadInterpreted(t *testing.T) {
+	interpreter, err := testgrammar.Interpreter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := interpreter.Parse("Nest", nested(64))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Error("a nest 64 deep took more than 10 seconds")
+	}
+}
@@ -0,0 +1,560 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A stack-safe parser is a table of nodes, interpreted by a machine which keeps
+// its own stack of frames on the heap. Deeply nested input only makes that
+// stack longer, rather than exhausting the goroutine's stack.
+
+// machineSession emits the Session type for stack-safe parsers, which holds a
+// table for each memoized node.
+func (state *State) machineSession(names []string, memo map[string]bool, options Options) string {
+	table, window, tables, slots := "[]map[int]machineEntry", "", "\n\tmemo []map[int]machineEntry", ""
+	if options.Memo == MemoDense {
+		table, window = "[][]machineEntry", `
+	window   int  // The position of the first slot in each memoization table`
+		tables, slots = "\n\tmemo  [][]machineEntry\n\tslots [][]int32", "\n\t\tsession.slots = make([][]int32, len(machine))"
+	}
+	file := `
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	stack []machineFrame
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset` + window + `
+	// Internal memoization tables, by node` + tables + `
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+` + sessionReader + `
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.memo == nil {
+		session.memo = make(` + table + `, len(machine))` + slots + `
+	}
+	for node := range machine {
+		if !machine[node].memo {
+			continue
+		}`
+	switch options.Memo {
+	case MemoMaps:
+		file += `
+		if session.memo[node] == nil {
+			session.memo[node] = map[int]machineEntry{}
+		}
+		for key := range session.memo[node] {
+			delete(session.memo[node], key)
+		}
+	}
+}
+
+func (session *Session) recall(node int, here int) (machineEntry, bool) {
+	entry, ok := session.memo[node][here]
+	return entry, ok
+}
+
+func (session *Session) remember(node int, here int, entry machineEntry) {
+	_, replaced := session.memo[node][here]
+	session.memo[node][here] = entry
+	if !replaced {
+		session.count(here)
+	}
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	session.memos = 0
+	for node := range session.memo {
+		for key := range session.memo[node] {
+			if all || key < before {
+				delete(session.memo[node], key)
+			}
+		}
+		session.memos += len(session.memo[node])
+	}
+}
+`
+	case MemoDense:
+		file += `
+		session.slots[node] = session.emptySlots(session.slots[node])
+		session.memo[node] = session.memo[node][:0]
+	}
+	session.window = 0
+}
+
+func (session *Session) recall(node int, here int) (machineEntry, bool) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slots[node]) && session.slots[node][slot] != 0 {
+		return session.memo[node][session.slots[node][slot]-1], true
+	}
+	return machineEntry{}, false
+}
+
+func (session *Session) remember(node int, here int, entry machineEntry) {
+	if here < session.window {
+		return
+	}
+	session.memo[node] = append(session.memo[node], entry)
+	*session.slot(&session.slots[node], here) = int32(len(session.memo[node]))
+	session.count(here)
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	if before < session.window {
+		before = session.window
+	}
+	session.memos = 0
+	for node := range session.memo {
+		kept := session.memo[node]
+		session.memo[node] = nil
+		for slot := range session.slots[node] {
+			index := int32(0)
+			if from := slot + before - session.window; !all && from < len(session.slots[node]) && session.slots[node][from] != 0 {
+				session.memo[node] = append(session.memo[node], kept[session.slots[node][from]-1])
+				index = int32(len(session.memo[node]))
+			}
+			session.slots[node][slot] = index
+		}
+		session.memos += len(session.memo[node])
+	}
+	session.window = before
+}
+` + denseSlots
+	}
+	return file
+}
+
+// machine emits the node table for a stack-safe parser, the machine which runs
+// it, and typed entry points for the exported roots. It fails if a node isn't
+// one of the types in this package, which the machine can't run.
+func (state *State) machine(exported []string, names []string, memo map[string]bool) (string, error) {
+	index := map[string]int{}
+	for i, name := range names {
+		index[name] = i
+	}
+
+	file := ""
+	for _, root := range exported {
+		id := state.Roots[root]
+		returns := state.Definitions[id].Result
+		file += `
+func (session *Session) ` + id + `(here int) (peg.Result, ` + returns + `) {
+	check, value := session.run(` + fmt.Sprint(index[id]) + `, here)
+	result, _ := value.(` + returns + `)
+	return check, result
+}
+`
+	}
+
+	file += "\nvar machine = []machineNode{"
+	for _, name := range names {
+		definition := state.Definitions[name]
+		children := []string{}
+		for _, id := range definition.Uses {
+			children = append(children, fmt.Sprint(index[id]))
+		}
+		fields := fmt.Sprintf("children: []int{%s}, memo: %t", strings.Join(children, ", "), memo[name])
+		if definition.Root {
+			file += "\n\t/* " + name + " */ {kind: machineRoot, " + fields + "},"
+			continue
+		}
+		switch node := definition.Node.(type) {
+		case Literal:
+			fields = fmt.Sprintf("kind: machineLiteral, %s, text: %q", fields, string(node))
+		case Sequence:
+			build := "\n\t\tvar result " + node.TypeName()
+			for i := range node {
+				build += fmt.Sprintf("\n\t\tresult.V%d, _ = values[%d].(%s)", i, i, node[i].TypeName())
+			}
+			for i := range node {
+				if _, ok := node[i].(Cut); ok {
+					fields = fmt.Sprintf("%s, commit: %d", fields, i+1)
+					break
+				}
+			}
+			if node.opens() {
+				fields += ", opens: true"
+			}
+			fields = fmt.Sprintf("kind: machineSequence, %s, build: func(values []interface{}) interface{} {%s\n\t\treturn result\n\t}", fields, build)
+		case Alternate:
+			fields = "kind: machineAlternate, " + fields
+		case Star:
+			fields = fmt.Sprintf("kind: machineStar, %s, build: %s", fields, machineSlice(node.Argument))
+		case Plus:
+			fields = fmt.Sprintf("kind: machinePlus, %s, build: %s", fields, machineSlice(node.Argument))
+		case Not:
+			fields = fmt.Sprintf("kind: machineNot, %s, text: %q", fields, describe(node.Argument))
+		case And:
+			fields = "kind: machineAnd, " + fields
+		case Go:
+			fields = fmt.Sprintf(`kind: machineGo, %s, apply: func(value interface{}) interface{} {
+		arg, _ := value.(%s)
+		return func(arg %s) %s {
+			return %s
+		}(arg)
+	}`, fields, node.Argument.TypeName(), node.Argument.TypeName(), node.Returns, node.Expression)
+		case Try:
+			fields = fmt.Sprintf(`kind: machineTry, %s, fatal: %t, try: func(value interface{}) (interface{}, error) {
+		arg, _ := value.(%s)
+		return func(arg %s) (%s, error) {
+			return %s
+		}(arg)
+	}`, fields, node.Fatal, node.Argument.TypeName(), node.Argument.TypeName(), node.Returns, node.Expression)
+		case Regex:
+			regex := "resource" + name + definition.Resources[0].Name
+			fields = fmt.Sprintf("kind: machineRegex, %s, text: %q, match: %s.FindIndex, read: %s.FindReaderIndex", fields, node.Regex, regex, regex)
+		case Contents:
+			fields = "kind: machineContents, " + fields
+		case Alias:
+			fields = fmt.Sprintf("kind: machineAlias, %s, text: %q", fields, node.Name)
+		case Cut:
+			fields = "kind: machineCut, " + fields
+		case Label:
+			fields = fmt.Sprintf("kind: machineLabel, %s, text: %q, opening: %t", fields, node.Message, node.Opening)
+		case Recover:
+			placeholder := ""
+			if node.Placeholder != "" {
+				placeholder = " = " + node.Placeholder
+			}
+			fields = fmt.Sprintf(`kind: machineRecover, %s, apply: func(interface{}) interface{} {
+		var placeholder %s%s
+		return placeholder
+	}`, fields, node.TypeName(), placeholder)
+		case Optional:
+			fields = fmt.Sprintf(`kind: machineOptional, %s, apply: func(value interface{}) interface{} {
+		element, _ := value.(%s)
+		return &element
+	}`, fields, node.Argument.TypeName())
+		default:
+			return "", fmt.Errorf("stack-safe parsers can't contain %T nodes", node)
+		}
+		file += "\n\t/* " + name + " */ {" + fields + "},"
+	}
+	return file + "\n}\n" + machineRun, nil
+}
+
+// machineSlice emits a function collecting values into a slice of the given
+// node's type.
+func machineSlice(element Peg) string {
+	return `func(values []interface{}) interface{} {
+		result := []` + element.TypeName() + `{}
+		for _, value := range values {
+			element, _ := value.(` + element.TypeName() + `)
+			result = append(result, element)
+		}
+		return result
+	}`
+}
+
+const machineRun = `
+const (
+	machineRoot = iota
+	machineLiteral
+	machineSequence
+	machineAlternate
+	machineStar
+	machinePlus
+	machineNot
+	machineAnd
+	machineGo
+	machineRegex
+	machineContents
+	machineOptional
+	machineAlias
+	machineCut
+	machineLabel
+	machineRecover
+	machineTry
+)
+
+type machineNode struct {
+	kind     int
+	children []int
+	memo     bool
+	commit   int                                    // How many children of a sequence precede and include its cut, if it has one
+	opens    bool                                   // Whether a sequence has a label after its first child, so holds its start
+	fatal    bool                                   // Whether a try's error is fatal
+	opening  bool                                   // Whether a label's message says where its sequence began
+	text     string                                 // The literal, regex, expression excluded, alias or label
+	match    func([]byte) []int                     // Finds the regex
+	read     func(io.RuneReader) []int              // Finds the regex in streamed input
+	build    func([]interface{}) interface{}        // Builds the value of a sequence, star or plus
+	apply    func(interface{}) interface{}          // Computes the value of a go or optional, or a recover's placeholder
+	try      func(interface{}) (interface{}, error) // Computes the value of a try, or its error
+}
+
+type machineFrame struct {
+	node   int // The node being parsed
+	start  int // Where the node began
+	here   int // How far the node has got
+	step   int // How many children have been started
+	mark   int // The node's hold, if it has one
+	values []interface{}
+	failed peg.Result // The farthest failure of an alternate's children
+	named  peg.Mark   // The failures tracked when an alias or a try began
+
+	recovered []*peg.ParseError // The errors recovered from by the node's children, or the node itself
+}
+
+// machineHolds says whether the node holds its position while its children are
+// parsed, since it may go back to it or need its input.
+func machineHolds(node *machineNode) bool {
+	switch node.kind {
+	case machineAlternate, machineStar, machinePlus, machineNot, machineAnd, machineContents, machineOptional, machineRecover:
+		return true
+	}
+	return node.opens
+}
+
+// machineEntry is a memoized result. It's silent if it was found inside a
+// negative lookahead, whose failures weren't recorded, so only another
+// lookahead may use it; outside of one it's parsed again and replaced.
+type machineEntry struct {
+	result peg.Result
+	value  interface{}
+	silent bool
+}
+
+// run parses the given node. When a frame asks for a child to be parsed, the
+// child is pushed onto the stack; when it finishes, result and value hold its
+// outcome for the frame below it.
+func (session *Session) run(start int, here int) (peg.Result, interface{}) {
+	stack := append(session.stack[:0], machineFrame{node: start, start: here, here: here})
+	session.enter(here)
+	var result peg.Result
+	var value interface{}
+	for {
+		frame := &stack[len(stack)-1]
+		node := &machine[frame.node]
+		entry, recalled := machineEntry{}, false
+		if frame.step == 0 && node.memo {
+			entry, recalled = session.recall(frame.node, frame.start)
+			recalled = recalled && (!entry.silent || session.failures.Silent != 0)
+		}
+		if recalled {
+			result, value = entry.result, entry.value
+		} else {
+			next := -1
+			switch node.kind {
+			case machineRoot:
+				if frame.step == 0 {
+					next = node.children[0]
+				}
+			case machineLiteral:
+				here := frame.here
+				if !session.available(here, here+len(node.text)) || string(session.slice(here, here+len(node.text))) != node.text {
+					result, value = session.failures.Fail(here, peg.Expected{Token: node.text}), ""
+				} else {
+					result, value = peg.Success(here+len(node.text)), node.text
+				}
+			case machineSequence:
+				if frame.step > 0 {
+					if !result.Ok {
+						result.Fatal = result.Fatal || node.commit > 0 && frame.step > node.commit
+						if frame.step > 1 && machine[node.children[frame.step-1]].kind == machineLabel {
+							result = session.failures.Open(result, frame.start)
+						}
+						value = nil
+						break
+					}
+					frame.here = result.At
+					frame.values = append(frame.values, value)
+					frame.recovered = append(frame.recovered, result.Recovered...)
+				}
+				if frame.step < len(node.children) {
+					next = node.children[frame.step]
+					break
+				}
+				result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.build(frame.values)
+			case machineAlternate:
+				if frame.step == 0 {
+					frame.failed = peg.Result{At: frame.start}
+				} else {
+					if result.Ok || result.Fatal {
+						break
+					}
+					frame.failed = peg.Farthest(frame.failed, result)
+				}
+				if frame.step < len(node.children) {
+					next = node.children[frame.step]
+					break
+				}
+				result, value = frame.failed, nil
+			case machineStar, machinePlus:
+				if frame.step > 0 {
+					if !result.Ok && (node.kind == machinePlus && len(frame.values) == 0 || result.Fatal) {
+						value = nil
+						break
+					}
+					if !result.Ok || result.At == frame.here && (node.kind == machineStar || len(frame.values) != 0) {
+						if result.Ok {
+							session.failures.Discard(result.Recovered)
+						}
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.build(frame.values)
+						break
+					}
+					frame.here = result.At
+					frame.values = append(frame.values, value)
+					frame.recovered = append(frame.recovered, result.Recovered...)
+					session.advance(frame.mark, frame.here)
+				}
+				next = node.children[0]
+			case machineNot:
+				if frame.step == 0 {
+					next = node.children[0]
+					session.failures.Silent++
+				} else if session.failures.Silent--; !result.Ok {
+					result, value = peg.Success(frame.start), struct{}{}
+				} else {
+					result, value = session.failures.Fail(frame.start, peg.Exclude{Message: node.text}), struct{}{}
+				}
+			case machineAnd:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					result.Fatal, value = false, nil
+				} else {
+					result = peg.Success(frame.start)
+				}
+			case machineGo:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					value = nil
+				} else {
+					value = node.apply(value)
+				}
+			case machineTry:
+				if frame.step == 0 {
+					if !node.fatal {
+						frame.named = session.failures.Save()
+					}
+					next = node.children[0]
+				} else if !result.Ok {
+					value = nil
+				} else if answer, err := node.try(value); err == nil {
+					value = answer
+				} else if node.fatal {
+					result, value = session.failures.Abort(frame.start, peg.Invalid{Err: err}), answer
+				} else {
+					result, value = session.failures.Reject(frame.named, frame.start, result.At, peg.Invalid{Err: err}), answer
+				}
+			case machineRegex:
+				here := frame.here
+				var match []int
+				if session.source == nil {
+					match = node.match(session.slice(here, session.end()))
+				} else {
+					match = node.read(session.runes(here))
+				}
+				if match == nil {
+					result, value = session.failures.Fail(here, peg.ExpectedPattern{Regex: node.text}), ""
+				} else {
+					result, value = peg.Success(here+match[1]), string(session.slice(here, here+match[1]))
+				}
+			case machineContents:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					value = ""
+				} else {
+					value = string(session.slice(frame.start, result.At))
+				}
+			case machineAlias:
+				if frame.step == 0 {
+					frame.named = session.failures.Mark()
+					next = node.children[0]
+				} else if !result.Ok {
+					result = session.failures.Name(frame.named, session.named(frame.start, result.At), result, node.text)
+				}
+			case machineLabel:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if !result.Ok {
+					result = session.failures.Label(frame.start, node.text, node.opening)
+				}
+			case machineRecover:
+				switch {
+				case frame.step == 0:
+					next = node.children[0]
+				case frame.step == 1 && (result.Ok || session.failures.Silent != 0):
+				default:
+					if frame.step == 1 {
+						frame.recovered = []*peg.ParseError{session.failure(result)}
+						session.failures.Clear()
+					} else if session.failures.Silent--; !result.Ok {
+						frame.here = session.next(frame.here)
+					} else {
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.apply(nil)
+						break
+					}
+					if !session.available(frame.start, frame.here+1) {
+						result, value = peg.Result{Ok: true, At: frame.here, Recovered: frame.recovered}, node.apply(nil)
+						break
+					}
+					session.failures.Silent++
+					next = node.children[1]
+				}
+			case machineCut:
+				result, value = peg.Success(frame.here), struct{}{}
+			case machineOptional:
+				if frame.step == 0 {
+					next = node.children[0]
+				} else if result.Fatal {
+					value = nil
+				} else if !result.Ok {
+					result, value = peg.Success(frame.start), nil
+				} else {
+					value = node.apply(value)
+				}
+			}
+			if next >= 0 {
+				if frame.step == 0 && machineHolds(node) {
+					frame.mark = session.hold(frame.start)
+				}
+				frame.step++
+				stack = append(stack, machineFrame{node: next, start: frame.here, here: frame.here})
+				session.enter(frame.here)
+				continue
+			}
+			if frame.step > 0 && machineHolds(node) {
+				session.release(frame.mark)
+			}
+			if node.memo {
+				session.remember(frame.node, frame.start, machineEntry{result, value, session.failures.Silent != 0})
+			}
+		}
+		stack = stack[:len(stack)-1]
+		session.depth--
+		if len(stack) == 0 {
+			session.stack = stack
+			return result, value
+		}
+	}
+}
+`
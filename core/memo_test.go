@@ -0,0 +1,93 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// With MemoShared, roots and the nodes used in more than one place are
+// memoized, and a node used in only one place is inlined into the function of
+// the node using it, unless a Memo decides otherwise.
+func TestMemoShared(t *testing.T) {
+	never := core.Memo{Argument: core.Literal("never"), Enabled: false}
+	state := core.NewState()
+	state.DefineRoot("Doc", core.Sequence{
+		core.Literal("once"),
+		core.Literal("twice"), core.Literal("twice"),
+		never, never,
+		core.Memo{Argument: core.Literal("always"), Enabled: true},
+		core.Root{Name: "word", Type: "string"},
+	})
+	state.DefineRoot("word", core.Literal("word"))
+	source, err := state.GenerateWith("parse", core.Options{Policy: core.MemoShared})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, source)
+
+	ids := map[string]string{"word root": state.Roots["word"]}
+	for id, definition := range state.Definitions {
+		if literal, ok := definition.Node.(core.Literal); ok {
+			ids[string(literal)] = id
+		}
+	}
+	for _, test := range []struct {
+		node     string
+		memoized bool
+		function bool
+	}{
+		{"once", false, false},
+		{"twice", true, true},
+		{"never", false, true},
+		{"always", true, true},
+		{"word root", true, true},
+		{"word", false, false},
+	} {
+		id, ok := ids[test.node]
+		if !ok {
+			t.Fatalf("%s has no definition", test.node)
+		}
+		if memoized := strings.Contains(source, "where"+id+" "); memoized != test.memoized {
+			t.Errorf("%s: memoized is %t, want %t", test.node, memoized, test.memoized)
+		}
+		if function := strings.Contains(source, "func (session *Session) "+id+"("); function != test.function {
+			t.Errorf("%s: has a function is %t, want %t", test.node, function, test.function)
+		}
+	}
+}
+
+// Structurally equal nodes, even in different roots, share one definition and
+// so one memoization table.
+func TestSharedStructure(t *testing.T) {
+	state := core.NewState()
+	state.DefineRoot("Doc", core.Sequence{core.Literal("("), core.Literal("x"), core.Literal("(")})
+	state.DefineRoot("Open", core.Star{Argument: core.Literal("(")})
+	ids := []string{}
+	for id, definition := range state.Definitions {
+		if definition.Node == core.Peg(core.Literal("(")) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) != 1 {
+		t.Fatalf("Literal(\"(\") has the definitions %q", ids)
+	}
+	id := ids[0]
+	sequence := state.Definitions[state.Definitions[state.Roots["Doc"]].Uses[0]]
+	star := state.Definitions[state.Definitions[state.Roots["Open"]].Uses[0]]
+	if uses := sequence.Uses; len(uses) != 3 || uses[0] != id || uses[2] != id {
+		t.Errorf("the Sequence uses %q, not %s twice", uses, id)
+	}
+	if uses := star.Uses; len(uses) != 1 || uses[0] != id {
+		t.Errorf("the Star uses %q, not %s", uses, id)
+	}
+	source, err := state.GenerateWith("parse", core.Options{Policy: core.MemoShared})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, source)
+	if tables := strings.Count(source, "\twhere"+id+" "); tables != 1 {
+		t.Errorf("%s has %d memoization tables", id, tables)
+	}
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// rename gives new names to the top-level identifiers declared in the source,
+// as Options.Prefix and Options.Names ask, so that several generated parsers
+// can share a package. A field which embeds a renamed type is renamed with it,
+// wherever it is selected or set in a struct literal. The source must parse,
+// so an error in one of the grammar's Go expressions may be reported here.
+// Names must only rename identifiers that the source declares, and no two
+// identifiers, or an identifier and an import, may end up with the same name.
+func rename(source string, options Options) (string, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "parse.go", source, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("renaming identifiers: %w", err)
+	}
+	// The imported packages aren't available, so their names are left
+	// unresolved, and so are the errors that causes.
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}, Uses: map[*ast.Ident]types.Object{}}
+	config := types.Config{Importer: emptyImporter{}, Error: func(error) {}}
+	pkg, _ := config.Check(file.Name.Name, fileSet, []*ast.File{file}, info)
+	if err := checkNames(pkg.Scope(), file, options); err != nil {
+		return "", err
+	}
+	renamed := func(object types.Object) bool {
+		if object == nil || object.Pkg() != pkg {
+			return false
+		}
+		if field, ok := object.(*types.Var); ok && field.Embedded() {
+			object = embedded(field.Type())
+		}
+		return object != nil && object.Parent() == pkg.Scope()
+	}
+	// An embedded field's identifier both uses its type and defines the field.
+	idents := map[*ast.Ident]bool{}
+	for _, objects := range []map[*ast.Ident]types.Object{info.Defs, info.Uses} {
+		for ident, object := range objects {
+			if renamed(object) {
+				idents[ident] = true
+			}
+		}
+	}
+	for ident := range idents {
+		ident.Name = options.name(ident.Name)
+	}
+	var output bytes.Buffer
+	if err := format.Node(&output, fileSet, file); err != nil {
+		return "", fmt.Errorf("renaming identifiers: %w", err)
+	}
+	return output.String(), nil
+}
+
+// checkNames reports a name in Options.Names which nothing in the scope is
+// called, and a name which renaming would give to two identifiers declared in
+// the scope, or to one and an import of the file.
+func checkNames(scope *types.Scope, file *ast.File, options Options) error {
+	for _, old := range sortedKeys(options.Names) {
+		if scope.Lookup(old) == nil {
+			return fmt.Errorf("renaming identifiers: nothing generated is called %s", old)
+		}
+	}
+	owners := map[string]string{}
+	for _, imported := range file.Imports {
+		path, _ := strconv.Unquote(imported.Path.Value)
+		name := packageName(path)
+		if imported.Name != nil {
+			name = imported.Name.Name
+		}
+		owners[name] = "the import of " + path
+	}
+	for _, old := range scope.Names() {
+		name := options.name(old)
+		if owner, ok := owners[name]; ok {
+			return fmt.Errorf("renaming identifiers: %s and %s would both be called %s", owner, old, name)
+		}
+		owners[name] = old
+	}
+	return nil
+}
+
+// sortedKeys lists the keys of the map in order.
+func sortedKeys(names map[string]string) []string {
+	keys := []string{}
+	for key := range names {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// embedded is the declaration of the named type that a field of the given type
+// embeds, if it is one.
+func embedded(field types.Type) types.Object {
+	if pointer, ok := field.(*types.Pointer); ok {
+		field = pointer.Elem()
+	}
+	if named, ok := field.(*types.Named); ok {
+		return named.Obj()
+	}
+	return nil
+}
+
+// emptyImporter imports every package as if it declared nothing.
+type emptyImporter struct{}
+
+func (emptyImporter) Import(path string) (*types.Package, error) {
+	name := packageName(path)
+	pkg := types.NewPackage(path, name)
+	pkg.MarkComplete()
+	return pkg, nil
+}
+
+// name is the name to give the top-level identifier with the given name. The
+// prefix keeps exported identifiers exported and unexported ones unexported.
+func (options Options) name(name string) string {
+	if explicit, ok := options.Names[name]; ok {
+		return explicit
+	}
+	if options.Prefix == "" {
+		return name
+	}
+	prefix := []rune(options.Prefix)
+	if strings.HasPrefix(name, "New") && isExported(name[len("New"):]) {
+		// Constructors keep New at the front, as in NewJSONParser.
+		prefix[0] = unicode.ToUpper(prefix[0])
+		return "New" + string(prefix) + name[len("New"):]
+	}
+	if isExported(name) {
+		prefix[0] = unicode.ToUpper(prefix[0])
+		return string(prefix) + name
+	}
+	for i := 0; i < len(prefix) && unicode.IsUpper(prefix[i]); i++ {
+		prefix[i] = unicode.ToLower(prefix[i])
+	}
+	rest := []rune(name)
+	rest[0] = unicode.ToUpper(rest[0])
+	return string(prefix) + string(rest)
+}
+
+// fixedMethods are the exported methods that every Parser and Session has,
+// whatever its roots.
+var fixedMethods = []string{"Parser.NewSession", "Parser.NewReaderSession", "Session.Reset", "Session.ResetReader", "Session.Limit"}
+
+// checkMethods reports an exported root whose methods would clash with those
+// every Parser or Session has, or with another root's, as the Prefix method of
+// a root X does with a root named XPrefix.
+func checkMethods(exported []string) error {
+	owners := map[string]string{}
+	for _, method := range fixedMethods {
+		owners[method] = ""
+	}
+	for _, root := range exported {
+		if !token.IsIdentifier(root) {
+			return fmt.Errorf("root `%s` is exported, but its name isn't a Go identifier", root)
+		}
+		methods := []string{
+			"Session." + root, "Session." + root + "Prefix",
+			"Parser.Parse" + root, "Parser.Parse" + root + "Prefix", "Parser.Parse" + root + "Reader", "Parser.Parse" + root + "Context",
+		}
+		for _, method := range methods {
+			if owner, ok := owners[method]; ok && owner == "" {
+				return fmt.Errorf("root `%s` is exported, but every parser already has the method %s", root, method)
+			} else if ok {
+				return fmt.Errorf("roots `%s` and `%s` are both exported, and would both have the method %s", owner, root, method)
+			}
+			owners[method] = root
+		}
+	}
+	return nil
+}
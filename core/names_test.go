@@ -0,0 +1,257 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"reflect"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/twin"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/twinself"
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// Two prefixed parsers share each twin package. Those which import the runtime
+// report the same errors; self-contained ones have their own.
+func TestPrefixedParsers(t *testing.T) {
+	input := []byte("let x = (1;")
+	for name, parser := range map[string]interface {
+		ParseDoc(input []byte) ([]string, error)
+	}{
+		"twin.First":      twin.NewFirstParser(),
+		"twin.Second":     twin.NewSecondParser(),
+		"twinself.First":  twinself.NewFirstParser(),
+		"twinself.Second": twinself.NewSecondParser(),
+	} {
+		if values, err := parser.ParseDoc([]byte("let x = 1; print x;")); err != nil || len(values) != 2 {
+			t.Errorf("%s: got %v and %v", name, values, err)
+		}
+		if _, err := parser.ParseDoc(input); err == nil {
+			t.Errorf("%s: %q parsed", name, input)
+		}
+	}
+
+	_, err := twin.NewSecondParser().ParseDoc(input)
+	if failed := first(err); failed == nil || failed.Line != 1 || failed.Column != 11 {
+		t.Errorf("twin.Second: got %v", err)
+	}
+	_, err = twinself.NewSecondParser().ParseDoc(input)
+	var failed *twinself.SecondParseError
+	if !errors.As(err, &failed) || failed.SecondPosition != (twinself.SecondPosition{Offset: 10, Line: 1, Column: 11}) {
+		t.Errorf("twinself.Second: got %v", err)
+	}
+	if errors.As(err, new(*runtime.ParseError)) {
+		t.Errorf("twinself.Second: %v is a runtime.ParseError", err)
+	}
+}
+
+// Renaming needs the generated code to parse, so a mistake in a Go expression
+// is reported as an error, rather than left for the compiler to find.
+func TestRenameError(t *testing.T) {
+	state := core.NewState()
+	state.DefineRoot("Number", core.Go{Argument: core.Literal("1"), Returns: "int", Expression: "1 +"})
+	if _, err := state.GenerateWith("parse", core.Options{}); err != nil {
+		t.Errorf("without renaming, got %v", err)
+	}
+	if source, err := state.GenerateWith("parse", core.Options{Prefix: "Number"}); err == nil {
+		t.Errorf("with a prefix, got\n%s", source)
+	}
+}
+
+// Options.Names may only rename identifiers that are generated, and mustn't
+// give two of them the same name, or one the name of an import.
+func TestNamesErrors(t *testing.T) {
+	for _, test := range []struct {
+		prefix string
+		names  map[string]string
+		fails  bool
+	}{
+		{"", map[string]string{"Parser": "JSON"}, false},
+		{"", map[string]string{"Parser": "Session", "Session": "Parser"}, false},
+		{"JSON", map[string]string{"Parser": "JSON"}, false},
+		{"", map[string]string{"Parsr": "JSON"}, true},
+		{"", map[string]string{"ParseError": "JSONError"}, true},
+		{"", map[string]string{"Parser": "Session"}, true},
+		{"", map[string]string{"Parser": "JSON", "Session": "JSON"}, true},
+		{"JSON", map[string]string{"Parser": "JSONSession"}, true},
+		{"", map[string]string{"Parser": "peg"}, true},
+	} {
+		state := core.NewState()
+		state.DefineRoot("Number", core.Literal("1"))
+		source, err := state.GenerateWith("parse", core.Options{Prefix: test.prefix, Names: test.names})
+		if fails := err != nil; fails != test.fails {
+			t.Errorf("%q and %v: got %v", test.prefix, test.names, err)
+		} else if !fails {
+			check(t, source)
+		}
+	}
+}
+
+// roots defines each root, in the given order, with a node made of literals
+// that no other root uses.
+func roots(order []string) core.State {
+	nodes := map[string]core.Peg{
+		"sum":          core.Alternate{core.Sequence{core.Literal("1"), core.Literal("+")}, core.Sequence{core.Literal("2"), core.Literal("-")}},
+		"sum_alt0_seq": core.Literal("3"),
+		"a-b":          core.Literal("4"),
+		"a_b":          core.Literal("5"),
+		"a_2D_b":       core.Literal("6"),
+		"é":            core.Star{Argument: core.Literal("7")},
+		"skip": core.Recover{
+			Argument: core.Sequence{core.Literal("8"), core.Cut{}, core.Literal("9")},
+			Until:    core.Sequence{core.Literal(";"), core.Label{Argument: core.Literal("!"), Message: "bang"}},
+		},
+		"Named": core.Alias{Argument: core.Memo{Argument: core.Literal("0"), Enabled: false}, Name: "zero"},
+	}
+	state := core.NewState()
+	for _, root := range order {
+		state.DefineRoot(root, nodes[root])
+	}
+	return state
+}
+
+// Each node's ID is an identifier of its own, whatever its root is called, and
+// doesn't depend on the order in which roots that share no nodes are defined.
+func TestIDs(t *testing.T) {
+	order := []string{"sum", "sum_alt0_seq", "a-b", "a_b", "a_2D_b", "é", "skip", "Named"}
+	state := roots(order)
+	ids := map[string]string{}
+	for _, root := range order {
+		id := state.Roots[root]
+		if !token.IsIdentifier(id) {
+			t.Errorf("root %q has ID %q", root, id)
+		}
+		if other, ok := ids[id]; ok {
+			t.Errorf("roots %q and %q have the same ID %q", other, root, id)
+		}
+		ids[id] = root
+	}
+	// The sequences on either side of the Recover are defined separately.
+	recover := state.Definitions[state.Definitions[state.Roots["skip"]].Uses[0]]
+	if uses := recover.Uses; len(uses) != 2 || uses[0] == uses[1] || reflect.DeepEqual(state.Definitions[uses[0]].Node, state.Definitions[uses[1]].Node) {
+		t.Errorf("the Recover uses %q", uses)
+	}
+	for id := range state.Definitions {
+		if !token.IsIdentifier(id) {
+			t.Errorf("%q is not an identifier", id)
+		}
+	}
+	source, err := state.Generate("parse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, source)
+
+	reversed := []string{}
+	for i := range order {
+		reversed = append(reversed, order[len(order)-1-i])
+	}
+	other := roots(reversed)
+	if !reflect.DeepEqual(state.Roots, other.Roots) || !reflect.DeepEqual(state.Definitions, other.Definitions) {
+		t.Errorf("defining the roots in reverse changed their definitions")
+	}
+}
+
+// A node which several roots use is named after the root which defined it
+// first, and defining another root afterwards, whether it uses that node or
+// nothing the others use, never renames a node already defined.
+func TestSharedIDs(t *testing.T) {
+	nodes := map[string]core.Peg{
+		"Expr": core.Sequence{core.Literal("("), core.Star{Argument: core.Literal("x")}, core.Literal(")")},
+		"Aaa":  core.Literal("("),
+		"Zzz":  core.Star{Argument: core.Literal("x")},
+		"Bbb":  core.Literal("b"),
+	}
+	for _, test := range []struct {
+		order []string
+		uses  []string
+	}{
+		{[]string{"Expr", "Aaa", "Zzz", "Bbb"}, []string{"m_Expr_seq0_lit", "m_Expr_seq1_star", "m_Expr_seq2_lit"}},
+		{[]string{"Zzz", "Aaa", "Bbb", "Expr"}, []string{"m_Aaa_lit", "m_Zzz_star", "m_Expr_seq2_lit"}},
+	} {
+		state := core.NewState()
+		defined := map[string]core.Definition{}
+		for _, root := range test.order {
+			state.DefineRoot(root, nodes[root])
+			for id, definition := range defined {
+				if !reflect.DeepEqual(state.Definitions[id], definition) {
+					t.Errorf("%q: defining %s changed %s", test.order, root, id)
+				}
+			}
+			for id, definition := range state.Definitions {
+				defined[id] = definition
+			}
+		}
+		sequence := state.Definitions[state.Definitions[state.Roots["Expr"]].Uses[0]]
+		if !reflect.DeepEqual(sequence.Uses, test.uses) {
+			t.Errorf("%q: the Sequence uses %q, not %q", test.order, sequence.Uses, test.uses)
+		}
+		star := state.Definitions[test.uses[1]]
+		if uses := star.Uses; len(uses) != 1 || state.Definitions[uses[0]].Node != core.Peg(core.Literal("x")) {
+			t.Errorf("%q: the Star uses %q", test.order, uses)
+		}
+	}
+}
+
+// Nodes defined directly, outside any root, each have an ID of their own,
+// distinct from those of the nodes within roots.
+func TestDefineIDs(t *testing.T) {
+	state := core.NewState()
+	state.DefineRoot("x", core.Literal("x"))
+	nodes := []core.Peg{
+		core.Literal("a"),
+		core.Literal("b"),
+		core.Sequence{core.Literal("c"), core.Literal("x")},
+	}
+	ids := map[string]core.Peg{state.Roots["x"]: nil}
+	for _, id := range state.Definitions[state.Roots["x"]].Uses {
+		ids[id] = nil
+	}
+	for _, node := range nodes {
+		id := state.Define(node)
+		if _, ok := ids[id]; ok || !token.IsIdentifier(id) {
+			t.Errorf("%v has the ID %q", node, id)
+		}
+		ids[id] = node
+	}
+	for id, node := range ids {
+		if node != nil && !reflect.DeepEqual(state.Definitions[id].Node, node) {
+			t.Errorf("%s defines %v, not %v", id, state.Definitions[id].Node, node)
+		}
+	}
+	if id := state.Define(core.Literal("a")); ids[id] != core.Peg(core.Literal("a")) {
+		t.Errorf("defining Literal(\"a\") again gave %q", id)
+	}
+}
+
+// Exported roots whose methods would clash with each other's, or with those
+// every parser has, are rejected rather than generated.
+func TestMethodClash(t *testing.T) {
+	for _, test := range []struct {
+		roots []string
+		clash bool
+	}{
+		{[]string{"Reset"}, true},
+		{[]string{"ResetReader"}, true},
+		{[]string{"Limit"}, true},
+		{[]string{"Doc", "DocPrefix"}, true},
+		{[]string{"Doc", "DocReader"}, true},
+		{[]string{"Doc", "DocContext"}, true},
+		{[]string{"Doc-1"}, true},
+		{[]string{"Doc", "Word"}, false},
+		{[]string{"Doc", "ParseDoc", "doc-prefix"}, false},
+	} {
+		state := core.NewState()
+		for i, root := range test.roots {
+			state.DefineRoot(root, core.Literal(fmt.Sprint(i)))
+		}
+		source, err := state.Generate("parse")
+		if clash := err != nil; clash != test.clash {
+			t.Errorf("%q: got %v", test.roots, err)
+		} else if !clash {
+			check(t, source)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/dense"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/every"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/maps"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/stack"
+	"github.com/nathan-fenner/go-peg-tree/core/internal/testparse/stackdense"
+	"github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// parser is what every parser generated from testgrammar.Grammar provides.
+type parser interface {
+	ParseDoc(input []byte) ([]string, error)
+	ParseDocPrefix(input []byte) ([]string, int, error)
+	ParseDocReader(source io.Reader) ([]string, error)
+	ParseDocContext(ctx context.Context, input []byte, limits runtime.Limits) ([]string, error)
+	ParseNumber(input []byte) (string, error)
+	ParseItems(input []byte) ([]string, error)
+	ParseWord(input []byte) (string, error)
+	ParseLetters(input []byte) ([]string, error)
+	ParseLettersContext(ctx context.Context, input []byte, limits runtime.Limits) ([]string, error)
+	ParseNest(input []byte) (string, error)
+	ParseNestContext(ctx context.Context, input []byte, limits runtime.Limits) (string, error)
+	ParseFarthest(input []byte) (string, error)
+}
+
+// parsers are the generated parsers, by variant.
+var parsers = map[string]parser{
+	"maps":       maps.NewParser(),
+	"dense":      dense.NewParser(),
+	"every":      every.NewParser(),
+	"stack":      stack.NewParser(),
+	"stackdense": stackdense.NewParser(),
+}
+
+// session is what every Session generated from testgrammar.Grammar provides.
+type session interface {
+	Doc() ([]string, error)
+	Word() (string, error)
+	Reset(input []byte)
+	ResetReader(source io.Reader)
+	Limit(ctx context.Context, limits runtime.Limits)
+}
+
+// sessions make sessions of the generated parsers, by variant.
+var sessions = map[string]func(input []byte) session{
+	"maps":       func(input []byte) session { return maps.NewParser().NewSession(input) },
+	"dense":      func(input []byte) session { return dense.NewParser().NewSession(input) },
+	"every":      func(input []byte) session { return every.NewParser().NewSession(input) },
+	"stack":      func(input []byte) session { return stack.NewParser().NewSession(input) },
+	"stackdense": func(input []byte) session { return stackdense.NewParser().NewSession(input) },
+}
+
+// first is the first error that err reports.
+func first(err error) *runtime.ParseError {
+	var failed *runtime.ParseError
+	errors.As(err, &failed)
+	return failed
+}
+
+// show describes the outcome of a parse. A parse which failed without
+// recovering from anything has no value to show.
+func show(value interface{}, err error) string {
+	if _, failed := err.(*runtime.ParseError); failed {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v %v", value, err)
+}
+
+// summary describes the outcome of a parse more briefly than show: its value,
+// unless it failed without recovering from anything, then how many errors it
+// reported, the first one's message, and where the sequence that the first
+// interrupted began, if it knows.
+func summary(value interface{}, err error) string {
+	if err == nil {
+		return fmt.Sprint(value)
+	}
+	failed := first(err)
+	text := fmt.Sprintf("%d error: %s", count(err), failed.Message())
+	if count(err) != 1 {
+		text = fmt.Sprintf("%d errors: %s", count(err), failed.Message())
+	}
+	if failed.Opened != nil {
+		text += fmt.Sprintf(" (opened at %s)", failed.Opened)
+	}
+	if _, recovered := err.(*runtime.ParseError); !recovered {
+		text = fmt.Sprint(value) + " " + text
+	}
+	return text
+}
+
+// count is the number of errors that err reports.
+func count(err error) int {
+	if errs, ok := err.(interface{ Unwrap() []error }); ok {
+		return len(errs.Unwrap())
+	}
+	if err != nil {
+		return 1
+	}
+	return 0
+}
@@ -5,10 +5,23 @@ import (
 	"strings"
 )
 
+// ResourceScope says how often a Resource is created.
+type ResourceScope int
+
+const (
+	// ParseScope resources are created for each session, by NewSession.
+	ParseScope ResourceScope = iota
+	// GrammarScope resources are created once, when the generated package is
+	// initialized, and are shared by every session. They must be safe to use
+	// from several sessions at once.
+	GrammarScope
+)
+
 type Resource struct {
 	Name       string
 	Type       string
 	Expression string
+	Scope      ResourceScope
 }
 
 type Context struct {
@@ -27,10 +40,10 @@ type Literal string
 
 func (l Literal) Template(state *State, self string) string {
 	return fmt.Sprintf(`
-if here+%d > len(input) || string(input[here:here+%d]) != %q {
-	return Failure(Expected{Token: %q}), ""
+if !session.available(here, here+%d) || string(session.slice(here, here+%d)) != %q {
+	return session.failures.Fail(here, peg.Expected{Token: %q}), ""
 }
-return Success(here + %d), %q`, len(string(l)), len(string(l)), string(l), string(l), len(string(l)), string(l))
+return peg.Success(here + %d), %q`, len(string(l)), len(string(l)), string(l), string(l), len(string(l)), string(l))
 }
 func (l Literal) String() string {
 	return fmt.Sprintf("%q", string(l))
@@ -44,19 +57,47 @@ func (l Literal) Context() Context {
 
 type Sequence []Peg
 
+// Once a sequence has passed a Cut, the failure of any later element is fatal.
+// A Label after its first element reports where the sequence began, so the
+// sequence holds on to its start.
 func (s Sequence) Template(state *State, self string) string {
-	template := "\nresult := " + s.TypeName() + "{}"
+	template := "\nresult := " + s.TypeName() + "{}\nvar recovered []*peg.ParseError"
+	if s.opens() {
+		template = "\nstart := here\nmark := session.hold(here)\ndefer session.release(mark)" + template
+	}
+	cut := false
 	for i := range s {
+		fail := ""
+		if cut {
+			fail = "\n\tnext.Fatal = true"
+		}
+		if _, ok := s[i].(Label); ok && i > 0 {
+			fail += "\n\tnext = session.failures.Open(next, start)"
+		}
 		template += state.DefineIn(s[i], `
-if next, value := %s(input, here); next.Ok {
+if next, value := %s(here); next.Ok {
 	here = next.At
+	recovered = append(recovered, next.Recovered...)
 	result.V`+fmt.Sprintf("%d", i)+` = value
-} else {
+} else {`+fail+`
 	return next, `+s.TypeName()+`{}
 }`)
+		if _, ok := s[i].(Cut); ok {
+			cut = true
+		}
 	}
 	return template + `
-	return Success(here), result`
+	return peg.Result{Ok: true, At: here, Recovered: recovered}, result`
+}
+
+// opens says whether the sequence has a Label after its first element.
+func (s Sequence) opens() bool {
+	for i := 1; i < len(s); i++ {
+		if _, ok := s[i].(Label); ok {
+			return true
+		}
+	}
+	return false
 }
 func (s Sequence) String() string {
 	pieces := make([]string, len(s))
@@ -79,15 +120,16 @@ func (s Sequence) Context() Context {
 type Alternate []Peg
 
 func (a Alternate) Template(state *State, self string) string {
-	template := "\nnotes := []Reject{}\nvar next Result\n"
+	template := "\nmark := session.hold(here)\ndefer session.release(mark)\nfailed := peg.Result{At: here}\n"
 	for i := range a {
 		template += state.DefineIn(a[i], `
-if next, value := %s(input, here); next.Ok {
+if next, value := %s(here); next.Ok || next.Fatal {
 	return next, value
-}
-notes = append(notes, next.Expected...)`)
+} else {
+	failed = peg.Farthest(failed, next)
+}`)
 	}
-	template += "\nvar zero " + a.TypeName() + "\nreturn Failure(notes...), zero"
+	template += "\nvar zero " + a.TypeName() + "\nreturn failed, zero"
 	return template
 }
 func (a Alternate) String() string {
@@ -108,15 +150,30 @@ type Star struct {
 	Argument Peg
 }
 
+// An iteration which matches without consuming anything ends a Star, without
+// being counted, since it would match forever. The errors it recovered from
+// become ordinary failures, reported if the parse fails there.
 func (s Star) Template(state *State, self string) string {
 	return state.DefineIn(s.Argument, `
+mark := session.hold(here)
+defer session.release(mark)
 result := []`+s.Argument.TypeName()+`{}
+var recovered []*peg.ParseError
 for {
-	next, value := %s(input, here)
+	next, value := %s(here)
+	if next.Fatal {
+		return next, nil
+	}
 	if !next.Ok {
-		return Success(here), result
+		return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+	}
+	if next.At == here {
+		session.failures.Discard(next.Recovered)
+		return peg.Result{Ok: true, At: here, Recovered: recovered}, result
 	}
 	here = next.At
+	session.advance(mark, here)
+	recovered = append(recovered, next.Recovered...)
 	result = append(result, value)
 }`)
 }
@@ -134,18 +191,29 @@ type Plus struct {
 	Argument Peg
 }
 
+// Like a Star, a Plus ends with an iteration which consumes nothing, which is
+// only counted if it's the first.
 func (p Plus) Template(state *State, self string) string {
 	return state.DefineIn(p.Argument, `
+mark := session.hold(here)
+defer session.release(mark)
 result := []`+p.Argument.TypeName()+`{}
+var recovered []*peg.ParseError
 for {
-	next, value := %s(input, here)
+	next, value := %s(here)
 	if !next.Ok {
-		if len(result) == 0 {
+		if len(result) == 0 || next.Fatal {
 			return next, nil
 		}
-		return Success(here), result
+		return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+	}
+	if next.At == here && len(result) != 0 {
+		session.failures.Discard(next.Recovered)
+		return peg.Result{Ok: true, At: here, Recovered: recovered}, result
 	}
 	here = next.At
+	session.advance(mark, here)
+	recovered = append(recovered, next.Recovered...)
 	result = append(result, value)
 }`)
 }
@@ -163,13 +231,19 @@ type Not struct {
 	Argument Peg
 }
 
+// A lookahead only asks whether its argument matches, so a fatal failure
+// inside it, after a Cut or from a Label, is no different from any other.
 func (n Not) Template(state *State, self string) string {
 	return state.DefineIn(n.Argument, `
-check, _ := %s(input, here)
+mark := session.hold(here)
+defer session.release(mark)
+session.failures.Silent++
+check, _ := %s(here)
+session.failures.Silent--
 if !check.Ok {
-  return Success(here), struct{}{}
+  return peg.Success(here), struct{}{}
 }
-return Failure(Exclude{`+fmt.Sprintf("%q", n.Argument.String())+`}), struct{}{}`)
+return session.failures.Fail(here, peg.Exclude{Message: `+fmt.Sprintf("%q", describe(n.Argument))+`}), struct{}{}`)
 }
 func (n Not) String() string {
 	return "not (" + n.Argument.String() + ")"
@@ -181,18 +255,76 @@ func (n Not) Context() Context {
 	return Context{}
 }
 
+// describe says what a Not excludes, in the words of the failures its
+// argument would report: a literal is quoted, a regex is text matching it, and
+// an Alias or a Root goes by its name. Lookaheads and cuts consume nothing, so
+// they go unmentioned, and the description of a node it can't describe is "".
+func describe(node Peg) string {
+	parts := []string{}
+	separator := ""
+	switch node := node.(type) {
+	case Literal:
+		return fmt.Sprintf("%q", string(node))
+	case Regex:
+		return "text matching `" + node.Regex + "`"
+	case Alias:
+		return node.Name
+	case Root:
+		return node.Name
+	case Sequence:
+		for _, part := range node {
+			parts = append(parts, describe(part))
+		}
+		separator = " then "
+	case Alternate:
+		for _, option := range node {
+			parts = append(parts, describe(option))
+		}
+		separator = " or "
+	case Star:
+		return describe(node.Argument)
+	case Plus:
+		return describe(node.Argument)
+	case Optional:
+		return describe(node.Argument)
+	case Go:
+		return describe(node.Argument)
+	case Try:
+		return describe(node.Argument)
+	case Contents:
+		return describe(node.Argument)
+	case Label:
+		return describe(node.Argument)
+	case Recover:
+		return describe(node.Argument)
+	case Memo:
+		return describe(node.Argument)
+	}
+	described := []string{}
+	for _, part := range parts {
+		if part != "" {
+			described = append(described, part)
+		}
+	}
+	return strings.Join(described, separator)
+}
+
 type And struct {
 	Argument Peg
 }
 
+// As for Not, a fatal failure inside an And is an ordinary one.
 func (and And) Template(state *State, self string) string {
 	return state.DefineIn(and.Argument, `
-check, value := %s(input, here)
+mark := session.hold(here)
+defer session.release(mark)
+check, value := %s(here)
 if !check.Ok {
+	check.Fatal = false
 	var zero `+and.Argument.TypeName()+`
 	return check, zero
 }
-return Success(here), value`)
+return peg.Success(here), value`)
 }
 func (and And) String() string {
 	return "&(" + and.Argument.String() + ")"
@@ -230,7 +362,7 @@ type Go struct {
 
 func (g Go) Template(state *State, self string) string {
 	return state.DefineIn(g.Argument, `
-check, value := %s(input, here)
+check, value := %s(here)
 if !check.Ok {
 	var zero `+g.Returns+`
 	return check, zero
@@ -250,19 +382,70 @@ func (g Go) Context() Context {
 	return Context{}
 }
 
+// Try is like Go, but its Expression has the type (Returns, error). If the
+// error isn't nil, the node fails at its position with that error, which the
+// ParseError reports. Unless Fatal is set, the parse can still backtrack and
+// try something else.
+type Try struct {
+	Argument   Peg
+	Returns    string
+	Expression string
+	Fatal      bool
+}
+
+func (t Try) Template(state *State, self string) string {
+	save, fail := "\nmark := session.failures.Save()", "session.failures.Reject(mark, here, check.At, peg.Invalid{Err: err})"
+	if t.Fatal {
+		save, fail = "", "session.failures.Abort(here, peg.Invalid{Err: err})"
+	}
+	return state.DefineIn(t.Argument, save+`
+check, value := %s(here)
+if !check.Ok {
+	var zero `+t.Returns+`
+	return check, zero
+}
+answer, err := func(arg `+t.Argument.TypeName()+`) (`+t.Returns+`, error) {
+	return `+t.Expression+`
+}(value)
+if err != nil {
+	return `+fail+`, answer
+}
+return check, answer`)
+}
+func (t Try) String() string {
+	if t.Fatal {
+		return fmt.Sprintf("%s try! %s { %s }", t.Argument.String(), t.Returns, t.Expression)
+	}
+	return fmt.Sprintf("%s try %s { %s }", t.Argument.String(), t.Returns, t.Expression)
+}
+func (t Try) TypeName() string {
+	return t.Returns
+}
+func (t Try) Context() Context {
+	return Context{}
+}
+
 type Regex struct {
 	Regex string
 }
 
+// The compiled regex is anchored, so that matching at here gives up as soon
+// as it can't succeed, rather than searching the remainder of the input. When
+// the input is streamed, the regex reads as much of it as it needs.
 func (r Regex) Template(state *State, self string) string {
 	return fmt.Sprintf(`
-match := parser.resource%sRegex.FindIndex(input[here:])
-if match == nil || match[0] != 0 {
-	return Failure(Expected{Token: "regex " + %q}), ""
+var match []int
+if session.source == nil {
+	match = resource%sRegex.FindIndex(session.slice(here, session.end()))
+} else {
+	match = resource%sRegex.FindReaderIndex(session.runes(here))
+}
+if match == nil {
+	return session.failures.Fail(here, peg.ExpectedPattern{Regex: %q}), ""
 }
 end := match[1]
-return Success(here + end), string(input[here : here+end])
-`, self, r.Regex)
+return peg.Success(here + end), string(session.slice(here, here+end))
+`, self, self, r.Regex)
 }
 func (r Regex) String() string {
 	return fmt.Sprintf("regex %q", r.Regex)
@@ -273,7 +456,7 @@ func (r Regex) TypeName() string {
 func (r Regex) Context() Context {
 	return Context{
 		Imports:   []string{"regexp"},
-		Resources: []Resource{{Name: "Regex", Type: "*regexp.Regexp", Expression: fmt.Sprintf(`regexp.MustCompile(%q)`, r.Regex)}},
+		Resources: []Resource{{Name: "Regex", Type: "*regexp.Regexp", Expression: fmt.Sprintf(`regexp.MustCompile(%q)`, `^(?:`+r.Regex+`)`), Scope: GrammarScope}},
 	}
 }
 
@@ -283,9 +466,11 @@ type Contents struct {
 
 func (c Contents) Template(state *State, self string) string {
 	return state.DefineIn(c.Argument, `
-check, _ := %s(input, here)
+mark := session.hold(here)
+defer session.release(mark)
+check, _ := %s(here)
 if check.Ok {
-	return check, string(input[here:check.At])
+	return check, string(session.slice(here, check.At))
 }
 return check, ""
 `)
@@ -306,11 +491,16 @@ type Optional struct {
 
 func (o Optional) Template(state *State, self string) string {
 	return state.DefineIn(o.Argument, `
-check, value := %s(input, here)
+mark := session.hold(here)
+defer session.release(mark)
+check, value := %s(here)
 if check.Ok {
 	return check, &value
 }
-return Success(here), nil
+if check.Fatal {
+	return check, nil
+}
+return peg.Success(here), nil
 `)
 }
 func (o Optional) String() string {
@@ -322,3 +512,154 @@ func (o Optional) TypeName() string {
 func (o Optional) Context() Context {
 	return Context{}
 }
+
+// Cut commits the Sequence containing it to its remaining elements. Once it
+// has been passed, their failure is a fatal error at that position: no
+// enclosing Alternate tries its other options, and the parse fails.
+type Cut struct{}
+
+func (c Cut) Template(state *State, self string) string {
+	return "\nreturn peg.Success(here), struct{}{}"
+}
+func (c Cut) String() string {
+	return "~"
+}
+func (c Cut) TypeName() string {
+	return "struct{}"
+}
+func (c Cut) Context() Context {
+	return Context{}
+}
+
+// Alias names its argument in error messages. When it fails, the name is
+// reported instead of whatever its parts expected at the same position.
+type Alias struct {
+	Argument Peg
+	Name     string
+}
+
+func (a Alias) Template(state *State, self string) string {
+	return state.DefineIn(a.Argument, `
+mark := session.failures.Mark()
+check, value := %s(here)
+if !check.Ok {
+	return session.failures.Name(mark, session.named(here, check.At), check, `+fmt.Sprintf("%q", a.Name)+`), value
+}
+return check, value`)
+}
+func (a Alias) String() string {
+	return fmt.Sprintf("alias %q (%s)", a.Name, a.Argument.String())
+}
+func (a Alias) TypeName() string {
+	return a.Argument.TypeName()
+}
+func (a Alias) Context() Context {
+	return Context{}
+}
+
+// Label gives its argument's failure a message, reported instead of anything
+// expected there, such as "unclosed parenthesis". The failure is fatal, so no
+// enclosing Alternate can replace it with its own. When the Label is part of a
+// Sequence, the ParseError says where the sequence began, and so does the
+// message if Opening is set, as in "unclosed parenthesis opened at 3:5".
+type Label struct {
+	Argument Peg
+	Message  string
+	Opening  bool
+}
+
+func (l Label) Template(state *State, self string) string {
+	return state.DefineIn(l.Argument, `
+check, value := %s(here)
+if !check.Ok {
+	return session.failures.Label(here, `+fmt.Sprintf("%q, %t", l.Message, l.Opening)+`), value
+}
+return check, value`)
+}
+func (l Label) String() string {
+	if l.Opening {
+		return fmt.Sprintf("%s^^%q", l.Argument.String(), l.Message)
+	}
+	return fmt.Sprintf("%s^%q", l.Argument.String(), l.Message)
+}
+func (l Label) TypeName() string {
+	return l.Argument.TypeName()
+}
+func (l Label) Context() Context {
+	return Context{}
+}
+
+// Recover recovers from its argument's failure, even a fatal one. The error is
+// recorded, the input is skipped up to the next place where Until matches (or
+// the end of the input), and Placeholder, a Go expression of the argument's
+// type, stands in for its value; if Placeholder is empty, the zero value does.
+// The parse carries on, and reports every error it recovered from when it ends.
+// Failures inside a Not are never recovered from.
+//
+// A Recover which fails where Until matches, or at the end of the input, skips
+// nothing. A Star or Plus repeating it then stops, as it does for anything
+// which matches without consuming input.
+type Recover struct {
+	Argument    Peg
+	Until       Peg
+	Placeholder string
+}
+
+func (r Recover) Template(state *State, self string) string {
+	placeholder := "\nvar placeholder " + r.TypeName()
+	if r.Placeholder != "" {
+		placeholder += " = " + r.Placeholder
+	}
+	return state.DefineIn(r.Argument, `
+mark := session.hold(here)
+defer session.release(mark)
+check, value := %s(here)
+if check.Ok || session.failures.Silent != 0 {
+	return check, value
+}
+recovered := session.failure(check)
+session.failures.Clear()
+at := here
+for session.available(here, at+1) {
+	session.failures.Silent++`) + state.DefineIn(r.Until, `
+	sync, _ := %s(at)
+	session.failures.Silent--
+	if sync.Ok {
+		break
+	}
+	at = session.next(at)
+}`) + placeholder + `
+return peg.Result{Ok: true, At: at, Recovered: []*peg.ParseError{recovered}}, placeholder`
+}
+func (r Recover) String() string {
+	return fmt.Sprintf("recover (%s) until (%s)", r.Argument.String(), r.Until.String())
+}
+func (r Recover) TypeName() string {
+	return r.Argument.TypeName()
+}
+func (r Recover) Context() Context {
+	return Context{}
+}
+
+// Memo decides whether its argument is memoized, regardless of the policy
+// chosen when the parser is generated.
+type Memo struct {
+	Argument Peg
+	Enabled  bool
+}
+
+func (m Memo) Template(state *State, self string) string {
+	return `/* illegal - memo annotations are not generated */`
+}
+func (m Memo) String() string {
+	if m.Enabled {
+		return "@memo (" + m.Argument.String() + ")"
+	}
+	return "@nomemo (" + m.Argument.String() + ")"
+}
+func (m Memo) TypeName() string {
+	return m.Argument.TypeName()
+}
+func (m Memo) Context() Context {
+	return Context{}
+}
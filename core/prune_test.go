@@ -0,0 +1,110 @@
+package core_test
+
+import (
+	"go/ast"
+	"go/importer"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// Options.Prune leaves out the roots no exported root uses, with their imports
+// and memoization settings, and State.Prune reports them.
+func TestPrune(t *testing.T) {
+	build := func() core.State {
+		state := core.NewState()
+		state.DefineRoot("digit", core.Literal("0"))
+		state.DefineRoot("Number", core.Plus{Argument: core.Root{Name: "digit", Type: "string"}})
+		state.DefineRoot("unused", core.Regex{Regex: `[a-z]+`})
+		state.SetMemo("unused", true)
+		return state
+	}
+
+	state := build()
+	unused := state.Roots["unused"]
+	source, err := state.GenerateWith("parse", core.Options{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(source, unused) || strings.Contains(source, `"regexp"`) {
+		t.Errorf("the unused root was generated:\n%s", source)
+	}
+	if _, ok := state.Memo[unused]; ok {
+		t.Errorf("the unused root is still memoized")
+	}
+	if _, ok := state.Roots["digit"]; !ok {
+		t.Errorf("a used root was pruned")
+	}
+
+	state = build()
+	pruned := state.Prune()
+	if len(pruned.Roots) != 1 || pruned.Roots[0] != "unused" || len(pruned.Imports) != 1 || pruned.Imports[0] != "regexp" {
+		t.Errorf("got %+v", pruned)
+	}
+	state = build()
+	if source, _ := state.GenerateWith("parse", core.Options{}); !strings.Contains(source, unused) {
+		t.Errorf("the unused root was pruned without Prune")
+	}
+}
+
+// A pruned parser compiles, even once the only Go expression using an import
+// which the grammar added itself is gone, and GeneratePruned reports that
+// import along with the rest.
+func TestPruneImports(t *testing.T) {
+	state := core.NewState()
+	state.AddImport("strconv")
+	state.AddImport("strings")
+	state.DefineRoot("Number", core.Go{Argument: core.Literal("1"), Returns: "string", Expression: "strings.ToUpper(arg)"})
+	state.DefineRoot("count", core.Go{Argument: core.Literal("2"), Returns: "string", Expression: "strconv.Itoa(len(arg))"})
+	state.DefineRoot("word", core.Regex{Regex: `[a-z]+`})
+	source, pruned, err := state.GeneratePruned("parse", core.Options{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(source, `"strconv"`) || !strings.Contains(source, `"strings"`) {
+		t.Errorf("got the imports of\n%s", source)
+	}
+	check(t, source)
+	if len(pruned.Roots) != 2 || len(pruned.Imports) != 2 || pruned.Imports[0] != "regexp" || pruned.Imports[1] != "strconv" {
+		t.Errorf("got %+v", pruned)
+	}
+}
+
+// An import outside the standard library is kept even if its package seems
+// unused, since the package needn't be named after its path.
+func TestPruneImportsOutsideStandard(t *testing.T) {
+	state := core.NewState()
+	state.AddImports([]string{"strconv", "example.com/lib", "example.com/lib/v2", "local/lib"})
+	state.DefineRoot("Number", core.Go{Argument: core.Literal("1"), Returns: "string", Expression: "library.Name(arg)"})
+	source, pruned, err := state.GeneratePruned("parse", core.Options{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"example.com/lib", "example.com/lib/v2", "local/lib"} {
+		if !strings.Contains(source, strconv.Quote(path)) {
+			t.Errorf("%s was pruned from\n%s", path, source)
+		}
+	}
+	if len(pruned.Imports) != 1 || pruned.Imports[0] != "strconv" {
+		t.Errorf("got %+v", pruned)
+	}
+}
+
+// check type-checks the source of a generated parser, as the compiler would.
+func check(t *testing.T, source string) {
+	t.Helper()
+	fileSet := token.NewFileSet()
+	file, err := goparser.ParseFile(fileSet, "parse.go", source, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := types.Config{Importer: importer.ForCompiler(fileSet, "source", nil)}
+	if _, err := config.Check("parse", fileSet, []*ast.File{file}, nil); err != nil {
+		t.Errorf("%v in\n%s", err, source)
+	}
+}
@@ -0,0 +1,44 @@
+package core_test
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+// Many goroutines share each Parser. Run with -race to check that they share
+// nothing else.
+func TestSharedParser(t *testing.T) {
+	inputs := []string{
+		"let x = 1; print x (2.5);",
+		"let x = ; print (y;",
+		"print 1e999; let a = b;",
+		"let = 1; print !;",
+	}
+	for variant, parser := range parsers {
+		want := map[string]string{}
+		for _, input := range inputs {
+			values, err := parser.ParseDoc([]byte(input))
+			want[input] = fmt.Sprint(values, err)
+		}
+		var wait sync.WaitGroup
+		for i := 0; i < 32; i++ {
+			wait.Add(1)
+			go func(i int) {
+				defer wait.Done()
+				for j := 0; j < 50; j++ {
+					input := inputs[(i+j)%len(inputs)]
+					values, err := parser.ParseDoc([]byte(input))
+					if j%2 != 0 {
+						values, err = parser.ParseDocReader(bytes.NewReader([]byte(input)))
+					}
+					if got := fmt.Sprint(values, err); got != want[input] {
+						t.Errorf("%s: %q gave %s, not %s", variant, input, got, want[input])
+					}
+				}
+			}(i)
+		}
+		wait.Wait()
+	}
+}
@@ -0,0 +1,32 @@
+package core_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// A parser reading its input reports the same errors as one given all of it,
+// quoting the same input around them, even once it has discarded what came
+// before.
+func TestReaderErrors(t *testing.T) {
+	inputs := []string{
+		"print 1 2 3; garbage",
+		string(document(400)),
+		strings.Repeat("print x; ", 200) + "print (x;",
+		strings.Repeat("print é;\n", 200) + "let x = 1; garbage",
+		"print x;)" + strings.Repeat(";", 30) + "é;",
+	}
+	for _, input := range inputs {
+		for variant, parser := range parsers {
+			want := show(parser.ParseDoc([]byte(input)))
+			if got := show(parser.ParseDocReader(bytes.NewReader([]byte(input)))); got != want {
+				t.Errorf("%s: %.20q... gave\n%s\nnot\n%s", variant, input, got, want)
+			}
+			if got := show(parser.ParseDocReader(iotest.OneByteReader(bytes.NewReader([]byte(input))))); got != want {
+				t.Errorf("%s: %.20q... a byte at a time gave\n%s\nnot\n%s", variant, input, got, want)
+			}
+		}
+	}
+}
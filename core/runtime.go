@@ -0,0 +1,115 @@
+package core
+
+import (
+	_ "embed"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RuntimePath is the import path of the runtime package, which generated code
+// imports unless it is self-contained.
+const RuntimePath = "github.com/nathan-fenner/go-peg-tree/core/runtime"
+
+// runtimeName is the name generated code imports the runtime package under.
+// Templates always qualify the runtime's identifiers with it.
+const runtimeName = "peg"
+
+//go:embed runtime/runtime.go
+var runtimeFile string
+
+// runtimeSource is the runtime package's declarations, without its package
+// clause and imports, for copying into self-contained parsers.
+func runtimeSource() string {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "runtime.go", runtimeFile, parser.ImportsOnly)
+	if err != nil {
+		panic(err)
+	}
+	end := file.Name.End()
+	if len(file.Decls) != 0 {
+		end = file.Decls[len(file.Decls)-1].End()
+	}
+	return runtimeFile[fileSet.Position(end).Offset:]
+}
+
+// runtimeImports lists the imports that the runtime package requires.
+func runtimeImports() []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "runtime.go", runtimeFile, parser.ImportsOnly)
+	if err != nil {
+		panic(err)
+	}
+	names := []string{}
+	for _, spec := range file.Imports {
+		name, _ := strconv.Unquote(spec.Path.Value)
+		names = append(names, name)
+	}
+	return names
+}
+
+// runtimeExports is the set of identifiers the runtime package declares at top
+// level which are exported.
+func runtimeExports() map[string]bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "runtime.go", runtimeFile, 0)
+	if err != nil {
+		panic(err)
+	}
+	exported := map[string]bool{}
+	for name, object := range file.Scope.Objects {
+		if ast.IsExported(name) && object.Kind != ast.Bad {
+			exported[name] = true
+		}
+	}
+	return exported
+}
+
+// unqualify removes the package name from the source's references to the
+// runtime package, for self-contained parsers, which declare its identifiers
+// themselves. It only scans the source, so it works even if the source doesn't
+// parse.
+func unqualify(source string) string {
+	exported := runtimeExports()
+	fileSet := token.NewFileSet()
+	file := fileSet.AddFile("parse.go", -1, len(source))
+	var scan scanner.Scanner
+	scan.Init(file, []byte(source), nil, 0)
+	type lexeme struct {
+		pos     token.Pos
+		tok     token.Token
+		literal string
+	}
+	var output strings.Builder
+	last := 0
+	previous := [2]lexeme{}
+	for {
+		pos, tok, literal := scan.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.IDENT && exported[literal] && previous[1].tok == token.PERIOD && previous[0].tok == token.IDENT && previous[0].literal == runtimeName {
+			output.WriteString(source[last:file.Offset(previous[0].pos)])
+			last = file.Offset(pos)
+		}
+		previous[0], previous[1] = previous[1], lexeme{pos, tok, literal}
+	}
+	output.WriteString(source[last:])
+	return output.String()
+}
+
+// merge combines two lists of imports, in order and without duplicates.
+func merge(first []string, second []string) []string {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, name := range append(append([]string{}, first...), second...) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,529 @@
+// Package runtime holds the types shared by every generated parser, so that
+// several grammars can live in one package and their errors can be handled
+// alike. Generated code imports it as peg, unless it is generated
+// self-contained, in which case this file is copied into it instead.
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type Result struct {
+	Ok       bool
+	At       int
+	Expected []Reject
+	Fatal    bool // Whether the failure came after a cut, so nothing may backtrack from it
+
+	// Recovered lists the errors which Recover nodes skipped over on the way to
+	// a success.
+	Recovered []*ParseError
+}
+
+type Reject interface {
+	Reason() string
+}
+
+type Expected struct {
+	Token string
+}
+
+func (e Expected) Reason() string {
+	return fmt.Sprintf("%q", e.Token)
+}
+
+// ExpectedPattern is expected where text matching a regex should have been.
+type ExpectedPattern struct {
+	Regex string
+}
+
+func (e ExpectedPattern) Reason() string {
+	return "text matching `" + e.Regex + "`"
+}
+
+// ExpectedName is expected where something which the grammar names, with an
+// Alias, should have been.
+type ExpectedName struct {
+	Name string
+}
+
+func (e ExpectedName) Reason() string {
+	return e.Name
+}
+
+// ExpectedEnd is expected where the input should have ended.
+type ExpectedEnd struct{}
+
+func (e ExpectedEnd) Reason() string {
+	return "end of input"
+}
+
+// Labeled is expected where a node which the grammar gives a message, with a
+// Label, failed.
+type Labeled struct {
+	Message string
+	Opened  int  // The offset at which the sequence it interrupted began
+	Opening bool // Whether the message says where that was
+}
+
+func (e Labeled) Reason() string {
+	return e.Message
+}
+
+// Invalid is expected where a Try action returned an error for what its
+// argument matched.
+type Invalid struct {
+	Err error
+}
+
+func (e Invalid) Reason() string {
+	return e.Err.Error()
+}
+
+// Farthest is the failure which got further, or both combined if they failed
+// at the same position.
+func Farthest(first Result, second Result) Result {
+	switch {
+	case first.At > second.At:
+		return first
+	case second.At > first.At:
+		return second
+	}
+	return Result{
+		Ok:       false,
+		At:       first.At,
+		Expected: append(append([]Reject{}, first.Expected...), second.Expected...),
+	}
+}
+
+// Tracker records the farthest position at which any part of a parse failed,
+// and everything that was expected there, which is the most useful place to
+// report a failed parse.
+type Tracker struct {
+	FailedAt int
+	Expected []Reject
+	Silent   int // How many negative lookaheads are in progress, whose failures don't count
+}
+
+// Fail returns a failure at the given position, and records it unless the
+// tracker is silent.
+func (t *Tracker) Fail(at int, expected ...Reject) Result {
+	if t.Silent == 0 {
+		if at > t.FailedAt {
+			t.FailedAt, t.Expected = at, t.Expected[:0]
+		}
+		if at == t.FailedAt {
+			t.Expected = append(t.Expected, expected...)
+		}
+	}
+	return Result{Ok: false, At: at, Expected: expected}
+}
+
+// Mark is where a tracker was when a named node or a Try began.
+type Mark struct {
+	at       int
+	length   int
+	expected []Reject // What was expected, if the mark was saved
+}
+
+func (t *Tracker) Mark() Mark {
+	return Mark{at: t.FailedAt, length: len(t.Expected)}
+}
+
+// Save is like Mark, but keeps a copy of what was expected, so that Reject can
+// go back to it.
+func (t *Tracker) Save() Mark {
+	return Mark{at: t.FailedAt, length: len(t.Expected), expected: append([]Reject{}, t.Expected...)}
+}
+
+// Reject reports that the action of a Try, which began at the given position
+// with the given saved mark, rejected what its argument matched up to end. The
+// failures the argument recorded up to end are forgotten first: it matched
+// past them, so they mustn't outrank the action's error.
+func (t *Tracker) Reject(mark Mark, at int, end int, reject Reject) Result {
+	if t.Silent == 0 && t.FailedAt <= end {
+		t.FailedAt, t.Expected = mark.at, append(t.Expected[:0], mark.expected...)
+	}
+	return t.Fail(at, reject)
+}
+
+// Name reports that the node named by an Alias, which began at the given
+// position with the given mark, failed. Whatever its parts expected at that
+// position is replaced by its name, except for failures which explain
+// themselves, such as an error from a Try action. Failures further on are
+// kept, since they are more specific. An Alias which skips whitespace before
+// failing is named where the whitespace ends, which is the position to give
+// (see Blank).
+func (t *Tracker) Name(mark Mark, at int, failed Result, name string) Result {
+	if failed.At > at || failed.Fatal {
+		return failed
+	}
+	if t.Silent == 0 && t.FailedAt == at {
+		if mark.at == at {
+			t.Expected = t.Expected[:mark.length]
+		} else {
+			t.Expected = t.Expected[:0]
+		}
+	}
+	expected := []Reject{ExpectedName{Name: name}}
+	for _, reject := range failed.Expected {
+		if explains(reject) {
+			expected = append(expected, reject)
+		}
+	}
+	return t.Fail(at, expected...)
+}
+
+// Blank says whether the text is only whitespace. An Alias which consumed
+// nothing else before failing counts as failing where its name would begin.
+func Blank(text []byte) bool {
+	return bytes.IndexFunc(text, func(r rune) bool { return !unicode.IsSpace(r) }) < 0
+}
+
+// explains says whether a failure is described in the grammar's own words,
+// which an Alias mustn't replace with its name.
+func explains(reject Reject) bool {
+	switch reject.(type) {
+	case Labeled, Invalid:
+		return true
+	}
+	return false
+}
+
+// Label reports that a node which the grammar gives a message, with a Label,
+// failed at the given position. If opening is set, the message says where the
+// sequence it interrupted began.
+func (t *Tracker) Label(at int, message string, opening bool) Result {
+	return t.Abort(at, Labeled{Message: message, Opened: at, Opening: opening})
+}
+
+// Abort returns a fatal failure at the given position. What it expected
+// replaces everything expected so far, so nothing can backtrack and report
+// something else instead.
+func (t *Tracker) Abort(at int, expected Reject) Result {
+	if t.Silent == 0 {
+		t.FailedAt, t.Expected = at, append(t.Expected[:0], expected)
+	}
+	return Result{Ok: false, At: at, Expected: []Reject{expected}, Fatal: true}
+}
+
+// Open reports that a labeled failure interrupted a sequence which began at
+// the given position.
+func (t *Tracker) Open(failed Result, at int) Result {
+	if len(failed.Expected) != 1 {
+		return failed
+	}
+	label, ok := failed.Expected[0].(Labeled)
+	if !ok || label.Opened != failed.At {
+		return failed
+	}
+	label.Opened = at
+	failed.Expected = []Reject{label}
+	if t.Silent == 0 && t.FailedAt == failed.At && len(t.Expected) == 1 {
+		t.Expected[0] = label
+	}
+	return failed
+}
+
+// Discard records the errors that a match which is discarded recovered from as
+// ordinary failures, as when a Star stops at an iteration which consumed
+// nothing. If the parse fails there, they say why.
+func (t *Tracker) Discard(recovered []*ParseError) {
+	for _, err := range recovered {
+		t.Fail(err.Offset, err.Expected...)
+	}
+}
+
+// Clear forgets every failure.
+func (t *Tracker) Clear() {
+	t.FailedAt, t.Expected, t.Silent = 0, t.Expected[:0], 0
+}
+
+func Success(at int) Result {
+	return Result{
+		Ok: true,
+		At: at,
+	}
+}
+
+// Exclude is expected where something a lookahead excludes was found. Its
+// Message describes what was excluded, or is "" if that can't be put simply.
+type Exclude struct {
+	Message string
+}
+
+func (e Exclude) Reason() string {
+	if e.Message == "" {
+		return "something else"
+	}
+	return fmt.Sprintf("something other than %s", e.Message)
+}
+
+// Position is a place in the input. The beginning of the input is
+// Position{Line: 1, Column: 1}.
+type Position struct {
+	Offset int // In bytes, from 0
+	Line   int // From 1
+	Column int // In runes, from 1
+}
+
+// Advance finds the position after the text, which begins at this position.
+// Lines end with "\n"; a "\r" takes up no column, so "\r\n" endings count the
+// same way.
+func (p Position) Advance(text []byte) Position {
+	p.Offset += len(text)
+	for len(text) != 0 {
+		char, size := utf8.DecodeRune(text)
+		text = text[size:]
+		switch char {
+		case '\n':
+			p.Line, p.Column = p.Line+1, 1
+		case '\r':
+		default:
+			p.Column++
+		}
+	}
+	return p
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// FoundLength is the most bytes of input that a ParseError quotes on either
+// side of its position.
+const FoundLength = 32
+
+// ParseError reports that the input doesn't match the grammar.
+type ParseError struct {
+	Position
+	Expected []Reject
+	Found    string    // The input at the position, up to the end of its line
+	Label    string    // The message the grammar gives the failure, if any
+	Opened   *Position // Where the sequence the labeled failure interrupted began, if before it
+	Err      error     // The error a Try action returned, if one rejected the input there
+	before   string    // The input on the same line before the position
+	opening  bool      // Whether the message says where the sequence began
+	newline  bool      // Whether Found is empty because its line ends there
+}
+
+// NewParseError describes a failure at the given offset of the text, which
+// begins at origin.
+func NewParseError(origin Position, text []byte, at int, expected []Reject) *ParseError {
+	return new(Locator).ParseError(origin, text, at, expected)
+}
+
+// Locator finds the positions of offsets in a text, carrying on from the last
+// one it found, so that finding those of a series of errors (as a parse which
+// recovers from them does) takes time in proportion to the text.
+type Locator struct {
+	last Position
+}
+
+// locate finds the position of the offset in the text, which begins at origin.
+func (l *Locator) locate(origin Position, text []byte, offset int) Position {
+	from := origin
+	if l.last.Line != 0 && l.last.Offset >= origin.Offset && l.last.Offset <= offset {
+		from = l.last
+	}
+	l.last = from.Advance(text[from.Offset-origin.Offset : offset-origin.Offset])
+	return l.last
+}
+
+// ParseError is like NewParseError, for errors in the same text as the last
+// one the locator found.
+func (l *Locator) ParseError(origin Position, text []byte, at int, expected []Reject) *ParseError {
+	offset := at
+	at -= origin.Offset
+	rest := text[at:]
+	if len(rest) > FoundLength {
+		rest = rest[:FoundLength]
+	}
+	// The text may end mid-rune, when it is cut short here or when it is
+	// streamed and only part of the rune has been read.
+	for i := len(rest) - 1; i >= 0 && i >= len(rest)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(rest[i]) {
+			if !utf8.FullRune(rest[i:]) {
+				rest = rest[:i]
+			}
+			break
+		}
+	}
+	newline := false
+	if end := bytes.IndexAny(rest, "\r\n"); end >= 0 {
+		rest, newline = rest[:end], end == 0
+	}
+	start := bytes.LastIndexByte(text[:at], '\n') + 1
+	if at-start > FoundLength {
+		start = at - FoundLength
+		for start < at && !utf8.RuneStart(text[start]) {
+			start++
+		}
+	}
+	e := &ParseError{
+		Expected: expected,
+		Found:    string(rest),
+		before:   strings.TrimRight(string(text[start:at]), "\r"),
+		newline:  newline,
+	}
+	for _, reject := range expected {
+		switch reject := reject.(type) {
+		case Labeled:
+			if e.Label != "" {
+				continue
+			}
+			e.Label, e.opening = reject.Message, reject.Opening
+			if opened := reject.Opened - origin.Offset; opened >= 0 && opened < at {
+				position := l.locate(origin, text, reject.Opened)
+				e.Opened = &position
+			}
+		case Invalid:
+			if e.Err == nil {
+				e.Err = reject.Err
+			}
+		}
+	}
+	e.Position = l.locate(origin, text, offset)
+	return e
+}
+
+// Message describes the error in one line, such as
+//
+//	3:5: expected number, "(" or "-", found "]"
+//
+// or, if the grammar labels the failure (saying where its sequence began, if it
+// asks) or an action rejects the input,
+//
+//	3:9: unclosed parenthesis opened at 3:5
+//	3:5: strconv.ParseFloat: parsing "1e999": value out of range
+func (e *ParseError) Message() string {
+	if e.Label != "" {
+		if e.Opened != nil && e.opening {
+			return fmt.Sprintf("%s: %s opened at %s", e.Position, e.Label, e.Opened)
+		}
+		return fmt.Sprintf("%s: %s", e.Position, e.Label)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Position, e.Err)
+	}
+	reasons := []string{}
+	seen := map[string]bool{}
+	for _, reject := range e.Expected {
+		if reason := reject.Reason(); !seen[reason] {
+			seen[reason] = true
+			reasons = append(reasons, reason)
+		}
+	}
+	sort.Strings(reasons)
+	expected := "something else"
+	if len(reasons) != 0 {
+		expected = reasons[len(reasons)-1]
+	}
+	if len(reasons) > 1 {
+		expected = strings.Join(reasons[:len(reasons)-1], ", ") + " or " + expected
+	}
+	found := "end of input"
+	if e.Found != "" {
+		found = fmt.Sprintf("%q", e.Found)
+	} else if e.newline {
+		found = "end of line"
+	}
+	return fmt.Sprintf("%s: expected %s, found %s", e.Position, expected, found)
+}
+
+// Snippet shows the line of input containing the error, with a caret under
+// the position.
+func (e *ParseError) Snippet() string {
+	caret := []rune{}
+	for _, char := range e.before {
+		if char == '\t' {
+			caret = append(caret, '\t')
+		} else {
+			caret = append(caret, ' ')
+		}
+	}
+	return "\t" + e.before + e.Found + "\n\t" + string(caret) + "^"
+}
+
+func (e *ParseError) Error() string {
+	return e.Message() + "\n" + e.Snippet()
+}
+
+// Unwrap is the error returned by the action which rejected the input, if any.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors reports every error that a parse recovered from, followed by the
+// one which stopped it, if it didn't succeed.
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	messages := make([]string, len(e))
+	for i := range e {
+		messages[i] = e[i].Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+func (e ParseErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := range e {
+		errs[i] = e[i]
+	}
+	return errs
+}
+
+// Errors is the error ending a parse which recovered from the given errors,
+// and then failed with the given one unless it is nil. A parse which recovered
+// from nothing fails with a *ParseError; otherwise the error is a ParseErrors.
+func Errors(recovered []*ParseError, failed *ParseError) error {
+	if len(recovered) == 0 {
+		if failed == nil {
+			return nil
+		}
+		return failed
+	}
+	errs := append(ParseErrors{}, recovered...)
+	if failed != nil {
+		errs = append(errs, failed)
+	}
+	return errs
+}
+
+// Limits bound the work done by a parse, so that untrusted input can't make it
+// run forever or exhaust memory. Zero means unlimited.
+type Limits struct {
+	MaxInput int // The most bytes of input
+	MaxDepth int // The deepest nesting of nodes
+	MaxMemo  int // The most memoized results
+	MaxSteps int // The most nodes parsed, counting each attempt
+
+	// MemoBudget is how many memoized results to keep. Beyond it, results that
+	// the parse can no longer need are evicted, and then (if that isn't enough)
+	// all of them, which may cost time but never changes the outcome.
+	MemoBudget int
+}
+
+// LimitError reports that a parse was abandoned at the given position, since
+// it exceeded one of its Limits or its context was done.
+type LimitError struct {
+	Limit string // "input", "depth", "memo", "steps" or "context"
+	At    int
+	Err   error // The context's error
+}
+
+func (e *LimitError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("parse abandoned at %d: %v", e.At, e.Err)
+	}
+	return fmt.Sprintf("parse abandoned at %d: %s limit exceeded", e.At, e.Limit)
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,158 @@
+package runtime_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	. "github.com/nathan-fenner/go-peg-tree/core/runtime"
+)
+
+// start is the position of the beginning of the input.
+var start = Position{Line: 1, Column: 1}
+
+// An Alias replaces what its parts expected with its name, but not the errors
+// of Try actions or Labels, which say more.
+func TestNameKeepsExplanations(t *testing.T) {
+	rejected := Invalid{Err: strconv.ErrRange}
+	tests := []struct {
+		expected []Reject
+		message  string
+	}{
+		{[]Reject{ExpectedPattern{Regex: `[0-9]+`}}, "1:1: expected number, found \"x\""},
+		{[]Reject{rejected}, "1:1: value out of range"},
+		{[]Reject{ExpectedPattern{Regex: `[0-9]+`}, Labeled{Message: "not a number"}}, "1:1: not a number"},
+	}
+	for _, test := range tests {
+		tracker := Tracker{}
+		mark := tracker.Mark()
+		failed := tracker.Fail(0, test.expected...)
+		failed = tracker.Name(mark, 0, failed, "number")
+		err := NewParseError(start, []byte("x"), tracker.FailedAt, tracker.Expected)
+		if err.Message() != test.message {
+			t.Errorf("%v: got %q, want %q", test.expected, err.Message(), test.message)
+		}
+		if len(failed.Expected) != len(tracker.Expected) {
+			t.Errorf("%v: the result expects %v, but the tracker %v", test.expected, failed.Expected, tracker.Expected)
+		}
+	}
+	tracker := Tracker{}
+	failed := tracker.Name(tracker.Mark(), 0, tracker.Fail(0, rejected), "number")
+	if err := NewParseError(start, []byte("1e999"), 0, failed.Expected); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("the error %v doesn't wrap the Try's", err)
+	}
+}
+
+// A Locator finds the same positions as NewParseError, whichever order the
+// errors come in.
+func TestLocator(t *testing.T) {
+	text := []byte("one\ntwo é\r\nthree\n\tfour")
+	locator := Locator{}
+	for _, at := range []int{0, 5, 8, 2, 12, 12, 23, 19} {
+		expected := []Reject{Labeled{Message: "oops", Opened: at / 2}}
+		want := NewParseError(start, text, at, expected)
+		got := locator.ParseError(start, text, at, expected)
+		if got.Position != want.Position || (got.Opened == nil) != (want.Opened == nil) || got.Opened != nil && *got.Opened != *want.Opened {
+			t.Errorf("at %d: got %v (opened at %v), want %v (opened at %v)", at, got.Position, got.Opened, want.Position, want.Opened)
+		}
+	}
+}
+
+// The message quotes the rest of the line, or says that the line or the input
+// ends there.
+func TestFound(t *testing.T) {
+	expected := []Reject{Expected{Token: "+"}}
+	for _, test := range []struct {
+		text    string
+		at      int
+		message string
+	}{
+		{"one+twogarbage", 7, `1:8: expected "+", found "garbage"`},
+		{"one+two\nthree", 7, `1:8: expected "+", found end of line`},
+		{"one+two\r\nthree", 7, `1:8: expected "+", found end of line`},
+		{"one+two", 7, `1:8: expected "+", found end of input`},
+		{"one+two\n", 8, `2:1: expected "+", found end of input`},
+		{"one+twoé"[:8], 3, `1:4: expected "+", found "+two"`},
+	} {
+		if got := NewParseError(start, []byte(test.text), test.at, expected).Message(); got != test.message {
+			t.Errorf("%q at %d: got %q, want %q", test.text, test.at, got, test.message)
+		}
+	}
+}
+
+func reasons(expected []Reject) string {
+	reasons := []string{}
+	for _, reject := range expected {
+		reasons = append(reasons, reject.Reason())
+	}
+	return strings.Join(reasons, " ")
+}
+
+func TestFarthest(t *testing.T) {
+	a, b := Expected{Token: "a"}, Expected{Token: "b"}
+	tests := []struct {
+		first, second Result
+		at            int
+		expected      string
+	}{
+		{Result{At: 1, Expected: []Reject{a}}, Result{At: 2, Expected: []Reject{b}}, 2, `"b"`},
+		{Result{At: 3, Expected: []Reject{a}}, Result{At: 2, Expected: []Reject{b}}, 3, `"a"`},
+		{Result{At: 2, Expected: []Reject{a}}, Result{At: 2, Expected: []Reject{b}}, 2, `"a" "b"`},
+		{Result{At: 0}, Result{At: 0, Expected: []Reject{b}}, 0, `"b"`},
+	}
+	for _, test := range tests {
+		farthest := Farthest(test.first, test.second)
+		if farthest.Ok || farthest.At != test.at || reasons(farthest.Expected) != test.expected {
+			t.Errorf("Farthest(%v, %v) = %v", test.first, test.second, farthest)
+		}
+	}
+}
+
+// The tracker keeps what was expected at the farthest failure, except while it
+// is silent.
+func TestTracker(t *testing.T) {
+	type failure struct {
+		at     int
+		token  string
+		silent bool
+	}
+	tests := []struct {
+		failures []failure
+		at       int
+		expected string
+	}{
+		{[]failure{{0, "a", false}, {2, "b", false}, {1, "c", false}}, 2, `"b"`},
+		{[]failure{{2, "a", false}, {2, "b", false}, {2, "a", false}}, 2, `"a" "b" "a"`},
+		{[]failure{{1, "a", false}, {3, "b", true}, {1, "c", false}}, 1, `"a" "c"`},
+		{[]failure{{1, "a", false}, {3, "b", false}, {3, "c", true}}, 3, `"b"`},
+	}
+	for _, test := range tests {
+		tracker := Tracker{}
+		for _, failure := range test.failures {
+			if failure.silent {
+				tracker.Silent++
+			}
+			tracker.Fail(failure.at, Expected{Token: failure.token})
+			if failure.silent {
+				tracker.Silent--
+			}
+		}
+		if tracker.FailedAt != test.at || reasons(tracker.Expected) != test.expected {
+			t.Errorf("%v: failed at %d expecting %s", test.failures, tracker.FailedAt, reasons(tracker.Expected))
+		}
+	}
+}
+
+// A Try's error replaces the failures its argument recorded before matching
+// past them, but not those from before it began.
+func TestReject(t *testing.T) {
+	tracker := Tracker{}
+	tracker.Fail(0, Expected{Token: "a"})
+	mark := tracker.Save()
+	tracker.Fail(2, Expected{Token: "."})
+	failed := tracker.Reject(mark, 0, 3, Invalid{Err: strconv.ErrRange})
+	if failed.Ok || tracker.FailedAt != 0 || reasons(tracker.Expected) != `"a" value out of range` {
+		t.Errorf("failed at %d expecting %s", tracker.FailedAt, reasons(tracker.Expected))
+	}
+}
@@ -0,0 +1,41 @@
+package core_test
+
+import (
+	"bytes"
+	"testing"
+)
+
+// A session parses its input afresh for each root called on it, and again
+// after each Reset or ResetReader, giving what a new session would.
+func TestSessionReuse(t *testing.T) {
+	long := document(400)
+	for variant, parser := range parsers {
+		session := sessions[variant]([]byte("ab("))
+		session.Doc()
+		if got, want := show(session.Word()), show(parser.ParseWord([]byte("ab("))); got != want {
+			t.Errorf("%s: Word after Doc gave\n%s\nnot\n%s", variant, got, want)
+		}
+		if got, want := show(session.Doc()), show(parser.ParseDoc([]byte("ab("))); got != want {
+			t.Errorf("%s: Doc after Word gave\n%s\nnot\n%s", variant, got, want)
+		}
+
+		session.Reset([]byte("let x = 1; print x (y;"))
+		if got, want := show(session.Doc()), show(parser.ParseDoc([]byte("let x = 1; print x (y;"))); got != want {
+			t.Errorf("%s: Doc after Reset gave\n%s\nnot\n%s", variant, got, want)
+		}
+		session.Reset(long)
+		want := show(parser.ParseDoc(long))
+		if got := show(session.Doc()); got != want {
+			t.Errorf("%s: Doc of a longer input after Reset gave\n%s\nnot\n%s", variant, got, want)
+		}
+
+		session.ResetReader(bytes.NewReader(long))
+		if got := show(session.Doc()); got != want {
+			t.Errorf("%s: Doc after ResetReader gave\n%s\nnot\n%s", variant, got, want)
+		}
+		// What the first parse read is gone, so the session can't start over.
+		if _, err := session.Doc(); err == nil {
+			t.Errorf("%s: a second parse of a discarded input succeeded", variant)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package core_test
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// Stack-safe parsers keep their own stack on the heap, so input nested far
+// deeper than a small goroutine stack allows only costs them memory.
+func TestStackSafeNesting(t *testing.T) {
+	depth := 100000
+	input := strings.Repeat("(", depth) + "x" + strings.Repeat(")", depth)
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+	for _, variant := range []string{"stack", "stackdense"} {
+		value, err := parsers[variant].ParseNest([]byte(input))
+		if err != nil || value != "x" {
+			t.Errorf("%s: %d levels of nesting gave %q, %v", variant, depth, value, err)
+		}
+	}
+}
+
+// opaque is a node of a type outside core, which a stack-safe parser can't run.
+type opaque struct {
+	core.Literal
+}
+
+// Generating a stack-safe parser with a node of a type it can't run fails,
+// though the recursive parser can be generated.
+func TestStackSafeNodes(t *testing.T) {
+	state := core.NewState()
+	state.DefineRoot("Doc", opaque{core.Literal("x")})
+	if _, err := state.GenerateWith("parse", core.Options{StackSafe: true}); err == nil || !strings.Contains(err.Error(), "core_test.opaque") {
+		t.Errorf("generating a stack-safe parser gave %v", err)
+	}
+	source, err := state.GenerateWith("parse", core.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(t, source)
+}
@@ -1,6 +1,7 @@
 package core
 
 import (
+	"flag"
 	"fmt"
 	"sort"
 	"strings"
@@ -9,24 +10,37 @@ import (
 
 func NewState() State {
 	return State{
-		UID:         0,
 		Roots:       map[string]string{},
-		Imports:     []string{"fmt"},
+		Imports:     []string{"context", "errors", "io", "unicode/utf8"},
 		Definitions: map[string]Definition{},
+		Shared:      map[string]string{},
+		Memo:        map[string]bool{},
 	}
 }
 
 type Definition struct {
 	Resources []Resource
+	Imports   []string // The imports the body requires
+	Uses      []string // The IDs of the definitions the body refers to
 	Result    string
-	Body      string
+	Detail    string // A description of the node, for comments
+	Body      string // The body of the function which parses the node
+	Root      bool   // Roots only refer to the definition of their body
+	Node      Peg    // The node defined, unless this is a root
 }
 
 type State struct {
-	UID         int                   // For assigning unique identifiers
 	Roots       map[string]string     // The names roots
 	Imports     []string              // The imports collectively required
-	Definitions map[string]Definition // Definitions (from UID, not name)
+	Definitions map[string]Definition // Definitions (from ID, not name)
+	Shared      map[string]string     // IDs of definitions, by the structure of their node
+	Memo        map[string]bool       // Whether to memoize definitions (from ID), overriding the policy
+
+	scope    string   // The path of the node being defined, or "" outside any root
+	loose    int      // The number of nodes defined outside any root
+	parent   Peg      // The node being defined
+	children int      // The number of children of parent defined so far
+	uses     []string // The IDs that parent refers to
 }
 
 func (state *State) AddImport(name string) {
@@ -44,10 +58,90 @@ func (state *State) AddImports(names []string) {
 	}
 }
 
-func (state *State) UniqueID() string {
-	s := fmt.Sprintf("m%d", state.UID)
-	state.UID++
-	return s
+// UniqueID returns the identifier for the node at the given path, such as
+// "m_sum_alt0_seq" for "sum_alt0_seq". Paths begin with a sanitized root name,
+// or for nodes defined outside any root with their kind and a count, so no two
+// nodes have the same path.
+func (state *State) UniqueID(path string) string {
+	return "m_" + path
+}
+
+// sanitize makes a name usable as part of a Go identifier, keeping distinct
+// names distinct: ASCII letters and digits are kept, underscores are doubled,
+// and anything else becomes its code point in hex between underscores. The
+// paths of nodes within a root add a single underscore before each kind, so
+// they can't be mistaken for the name of another root either.
+func sanitize(name string) string {
+	clean := ""
+	for _, r := range name {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			clean += string(r)
+		case r == '_':
+			clean += "__"
+		default:
+			clean += fmt.Sprintf("_%X_", r)
+		}
+	}
+	return clean
+}
+
+// kind is a short description of a node, used to name it.
+func kind(peg Peg) string {
+	switch peg.(type) {
+	case Literal:
+		return "lit"
+	case Sequence:
+		return "seq"
+	case Alternate:
+		return "alt"
+	case Star:
+		return "star"
+	case Plus:
+		return "plus"
+	case Not:
+		return "not"
+	case And:
+		return "and"
+	case Go:
+		return "go"
+	case Try:
+		return "try"
+	case Regex:
+		return "regex"
+	case Contents:
+		return "contents"
+	case Optional:
+		return "opt"
+	case Recover:
+		return "recover"
+	case Alias:
+		return "alias"
+	case Label:
+		return "label"
+	case Cut:
+		return "cut"
+	case Memo:
+		return "memo"
+	}
+	return "node"
+}
+
+// path names the next child of the node being defined. Children of sequences,
+// alternates and recovers are numbered by their position. A node defined
+// outside any root is named after its kind and a count instead, following an
+// underscore: no sanitized root name begins with one and then a lower-case
+// letter.
+func (state *State) path(peg Peg) string {
+	if state.scope == "" {
+		state.loose++
+		return fmt.Sprintf("_%s%d", kind(peg), state.loose)
+	}
+	switch state.parent.(type) {
+	case Sequence, Alternate, Recover:
+		return fmt.Sprintf("%s%d_%s", state.scope, state.children, kind(peg))
+	}
+	return state.scope + "_" + kind(peg)
 }
 
 func (state *State) GetRootID(root string) string {
@@ -55,7 +149,7 @@ func (state *State) GetRootID(root string) string {
 	if ok {
 		return name
 	}
-	state.Roots[root] = state.UniqueID()
+	state.Roots[root] = state.UniqueID(sanitize(root))
 	return state.Roots[root]
 }
 
@@ -63,193 +157,1041 @@ func (state *State) DefineWithName(template string, name string, returns string,
 	state.Definitions[name] = Definition{
 		Resources: resources,
 		Result:    returns,
-		Body: `
-var where` + name + ` = map[int]Result{}
-var what` + name + ` = map[int]` + returns + `{}
-
-func (parser Parser) ` + name + `(input []byte, here int) (Result, ` + returns + `) {
-	if result, ok := parser.where` + name + `[here]; ok {
-		return result, parser.what` + name + `[here]
+		Detail:    detail,
+		Body:      template,
 	}
-	result, value := parser.d` + name + `(input, here)
-	parser.where` + name + `[here] = result
-	parser.what` + name + `[here] = value
-	return result, value
-}
-
-// ` + detail + `
-func (parser Parser) d` + name + `(input []byte, here int) (Result, ` + returns + `) {` +
-			strings.Replace(template, "\n", "\n\t", -1) + `
-}`}
 }
 
+// DefineRoot defines the root with the given name. A node used in several
+// places is named after the first of them to be defined, so that defining
+// another root never renames the nodes of those already defined.
 func (state *State) DefineRoot(root string, peg Peg) {
 	name := state.GetRootID(root)
+	state.scope, state.parent, state.children, state.uses = sanitize(root), nil, 0, nil
+	id := state.Define(peg)
 	state.Definitions[name] = Definition{
 		Result: peg.TypeName(),
-		Body: `
-func (parser Parser) ` + name + `(input []byte, here int) (Result, ` + peg.TypeName() + `) {
-  return parser.` + state.Define(peg) + `(input, here)
-}
-`,
+		Uses:   state.uses,
+		Detail: "root " + root,
+		Body:   "\nreturn session." + id + "(here)",
+		Root:   true,
 	}
+	state.scope, state.parent, state.children, state.uses = "", nil, 0, nil
+}
+
+// SetMemo decides whether the given root is memoized, regardless of the policy
+// chosen when the parser is generated.
+func (state *State) SetMemo(root string, memo bool) {
+	state.Memo[state.GetRootID(root)] = memo
 }
 
 func (state *State) Define(peg Peg) string {
+	id := state.define(peg)
+	state.uses = append(state.uses, id)
+	return id
+}
+
+func (state *State) define(peg Peg) string {
+	if memo, ok := peg.(Memo); ok {
+		id := state.define(memo.Argument)
+		state.Memo[id] = memo.Enabled
+		return id
+	}
 	context := peg.Context()
-	state.AddImports(context.Imports)
+	path := state.path(peg)
+	state.children++
 	if root, ok := peg.(Root); ok {
 		return state.GetRootID(root.Name)
 	}
-	id := state.UniqueID()
+	// Structurally identical nodes behave identically, so they share a single
+	// definition (and memoization table), named after the first path defined.
+	structure := fmt.Sprintf("%T %#v", peg, peg)
+	if shared, ok := state.Shared[structure]; ok {
+		return shared
+	}
+	id := state.UniqueID(path)
+	state.Shared[structure] = id
+	scope, parent, children, uses := state.scope, state.parent, state.children, state.uses
+	state.scope, state.parent, state.children, state.uses = path, peg, 0, nil
 	template := peg.Template(state, id)
 	state.DefineWithName(template, id, peg.TypeName(), peg.String(), context.Resources)
+	definition := state.Definitions[id]
+	definition.Imports = context.Imports
+	definition.Uses = state.uses
+	definition.Node = peg
+	state.Definitions[id] = definition
+	state.scope, state.parent, state.children, state.uses = scope, parent, children, uses
 	return id
 }
 func (state *State) DefineIn(peg Peg, source string) string {
 	id := state.Define(peg)
-	return fmt.Sprintf(source, "parser."+id)
+	return fmt.Sprintf(source, "session."+id)
+}
+
+func isExported(root string) bool {
+	return unicode.IsUpper([]rune(root)[0])
+}
+
+// imports lists (in order) the imports required by the state and by each of its
+// definitions.
+func (state *State) imports() []string {
+	imports := map[string]bool{}
+	for _, name := range state.Imports {
+		imports[name] = true
+	}
+	for _, definition := range state.Definitions {
+		for _, name := range definition.Imports {
+			imports[name] = true
+		}
+	}
+	names := []string{}
+	for name := range imports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Pruned reports what Prune removed.
+type Pruned struct {
+	Roots       []string // Roots that no exported root can reach
+	Definitions []string // IDs of definitions that no exported root can reach
+	Imports     []string // Imports that only the removed definitions required, or that nothing used
+}
+
+func (pruned Pruned) String() string {
+	s := ""
+	for _, root := range pruned.Roots {
+		s += "root " + root + "\n"
+	}
+	for _, id := range pruned.Definitions {
+		s += "definition " + id + "\n"
+	}
+	for _, name := range pruned.Imports {
+		s += "import " + name + "\n"
+	}
+	return s
+}
+
+// Prune removes every definition (with its resources and imports) that can't
+// be reached from an exported root, so that Generate emits only live code.
+// Imports added with AddImport are kept, since it can't tell which definitions
+// need them. GenerateWith calls it when Options.Prune is set, and then leaves
+// out those imports if nothing uses them; GeneratePruned reports both.
+func (state *State) Prune() Pruned {
+	before := state.imports()
+	live := map[string]bool{}
+	pending := []string{}
+	for root, id := range state.Roots {
+		if isExported(root) {
+			pending = append(pending, id)
+		}
+	}
+	for len(pending) != 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if live[id] {
+			continue
+		}
+		live[id] = true
+		pending = append(pending, state.Definitions[id].Uses...)
+	}
+
+	pruned := Pruned{}
+	for root, id := range state.Roots {
+		if !live[id] {
+			pruned.Roots = append(pruned.Roots, root)
+			delete(state.Roots, root)
+		}
+	}
+	for id := range state.Definitions {
+		if !live[id] {
+			pruned.Definitions = append(pruned.Definitions, id)
+			delete(state.Definitions, id)
+		}
+	}
+	for structure, id := range state.Shared {
+		if !live[id] {
+			delete(state.Shared, structure)
+		}
+	}
+	for id := range state.Memo {
+		if !live[id] {
+			delete(state.Memo, id)
+		}
+	}
+	after := map[string]bool{}
+	for _, name := range state.imports() {
+		after[name] = true
+	}
+	for _, name := range before {
+		if !after[name] {
+			pruned.Imports = append(pruned.Imports, name)
+		}
+	}
+	sort.Strings(pruned.Roots)
+	sort.Strings(pruned.Definitions)
+	return pruned
+}
+
+// MemoLayout selects how a generated parser stores memoized results.
+type MemoLayout int
+
+const (
+	// MemoMaps keeps a map from position to result for each node, which only
+	// grows as positions are visited.
+	MemoMaps MemoLayout = iota
+	// MemoDense keeps a slice of slots indexed by position for each node,
+	// sized to the input, which avoids hashing on every lookup. Each slot holds
+	// the index of a result in a second slice, which only grows as results are
+	// memoized; slots are int32s, so inputs must be shorter than 2GiB. Once
+	// results have to be evicted, the slots start where the parse can no longer
+	// backtrack to.
+	MemoDense
+)
+
+// MemoPolicy selects which nodes a generated parser memoizes.
+type MemoPolicy int
+
+const (
+	// MemoShared memoizes roots and the nodes which are used in more than one
+	// place. Other nodes are inlined into the function for the node using them.
+	MemoShared MemoPolicy = iota
+	// MemoEvery memoizes every node.
+	MemoEvery
+)
+
+// Options control the code that Generate emits.
+type Options struct {
+	Memo      MemoLayout // The layout of memoization tables
+	Policy    MemoPolicy // Which nodes to memoize, unless overridden by State.Memo
+	StackSafe bool       // Whether to parse with an explicit stack instead of recursion
+
+	// SelfContained copies the runtime package into the generated code, rather
+	// than importing it, so that the parser has no dependencies. Its types are
+	// then distinct from those of other parsers.
+	SelfContained bool
+
+	// Prune removes the definitions which no exported root uses from the
+	// state before generating, as State.Prune does, and leaves out the imports
+	// that nothing left uses.
+	Prune bool
+
+	// Prefix is put before every top-level identifier that Generate declares,
+	// such as Parser and NewParser, so that several parsers can share a
+	// package. Names gives explicit names to some of them instead, by their
+	// usual name; GenerateWith fails if it names something not generated, or
+	// if two identifiers would end up with the same name.
+	Prefix string
+	Names  map[string]string
+}
+
+// Flags defines command-line flags which set the options.
+func (options *Options) Flags(flags *flag.FlagSet) {
+	flags.Func("memo", "the layout of memoization tables: maps or dense", func(value string) error {
+		switch value {
+		case "maps":
+			options.Memo = MemoMaps
+		case "dense":
+			options.Memo = MemoDense
+		default:
+			return fmt.Errorf("unknown memo layout %q", value)
+		}
+		return nil
+	})
+	flags.Func("policy", "which nodes to memoize: shared or every", func(value string) error {
+		switch value {
+		case "shared":
+			options.Policy = MemoShared
+		case "every":
+			options.Policy = MemoEvery
+		default:
+			return fmt.Errorf("unknown memo policy %q", value)
+		}
+		return nil
+	})
+	flags.BoolVar(&options.StackSafe, "stack-safe", options.StackSafe, "parse with an explicit stack instead of recursion")
+	flags.BoolVar(&options.SelfContained, "self-contained", options.SelfContained, "copy the runtime package into the parser")
+	flags.BoolVar(&options.Prune, "prune", options.Prune, "leave out definitions that no exported root uses")
+	flags.StringVar(&options.Prefix, "prefix", options.Prefix, "a prefix for every generated top-level identifier")
+	flags.Func("name", "an explicit name for a generated identifier, as `Old=New` (repeatable)", func(value string) error {
+		pair := strings.SplitN(value, "=", 2)
+		if len(pair) != 2 || pair[0] == "" || pair[1] == "" {
+			return fmt.Errorf("expected Old=New, not %q", value)
+		}
+		if options.Names == nil {
+			options.Names = map[string]string{}
+		}
+		options.Names[pair[0]] = pair[1]
+		return nil
+	})
 }
 
-func (state *State) Generate(packageName string) string {
+func (state *State) Generate(packageName string) (string, error) {
+	return state.GenerateWith(packageName, Options{})
+}
+
+// GenerateWith emits the source of a parser for the grammar, in the given
+// package. It fails if the options can't be applied to the grammar, or if the
+// methods generated for its exported roots would clash.
+func (state *State) GenerateWith(packageName string, options Options) (string, error) {
+	file, _, err := state.generate(packageName, options)
+	return file, err
+}
+
+// GeneratePruned is GenerateWith, and also reports what Options.Prune left out,
+// if it is set: what State.Prune removes, and the imports added with AddImport
+// that nothing left uses.
+func (state *State) GeneratePruned(packageName string, options Options) (string, Pruned, error) {
+	return state.generate(packageName, options)
+}
+
+func (state *State) generate(packageName string, options Options) (string, Pruned, error) {
+	pruned := Pruned{}
+	if options.Prune {
+		pruned = state.Prune()
+	}
 	file := `package ` + packageName + `
 
 `
 
-	sort.Strings(state.Imports)
-	for _, name := range state.Imports {
+	imports := state.imports()
+	if options.SelfContained {
+		imports = merge(imports, runtimeImports())
+	}
+	for _, name := range imports {
 		file += fmt.Sprintf("\nimport %q", name)
 	}
+	if !options.SelfContained {
+		file += fmt.Sprintf("\nimport %s %q", runtimeName, RuntimePath)
+	}
 
 	exported := []string{}
-	for root, _ := range state.Roots {
-		if unicode.IsUpper([]rune(root)[0]) {
+	for root := range state.Roots {
+		if isExported(root) {
 			exported = append(exported, root)
 		}
 	}
-	for _, root := range exported {
-		definition := state.Definitions[state.Roots[root]]
-		id := state.GetRootID(root)
-		file += `
-func (parser Parser) ` + root + `() (` + definition.Result + `, error) {
-	check, value := parser.` + id + `([]byte(parser.input), 0)
-	if check.Ok {
-		return value, nil
+	sort.Strings(exported)
+	if err := checkMethods(exported); err != nil {
+		return "", pruned, err
 	}
-	var zero ` + definition.Result + `
-	return zero, fmt.Errorf("%s", check.Explain())
-}`
+
+	names := []string{}
+	for key := range state.Definitions {
+		names = append(names, key)
 	}
+	sort.Strings(names)
+	memo, inline := state.plan(options)
 
 	//////////////////////////////
 
 	file += `
 
-func NewParser(input string) Parser {
-	return Parser {
-		input: []byte(input),`
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
+
+func NewParser() Parser {
+	return Parser{}
+}
+`
 
-	for i, definition := range state.Definitions {
+	for _, root := range exported {
+		returns := state.Definitions[state.Roots[root]].Result
 		file += `
-		where` + i + `: map[int]Result{},
-		what` + i + `:  map[int]` + definition.Result + `{},`
-		for _, resource := range definition.Resources {
-			file += "\n\t\tresource" + i + resource.Name + ": " + resource.Expression + ","
-		}
+// Parse` + root + ` parses the whole input as ` + root + `, in a fresh session.
+func (parser Parser) Parse` + root + `(input []byte) (` + returns + `, error) {
+	return parser.NewSession(input).` + root + `()
+}
+
+// Parse` + root + `Prefix parses as much of the input as ` + root + ` matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) Parse` + root + `Prefix(input []byte) (` + returns + `, int, error) {
+	return parser.NewSession(input).` + root + `Prefix()
+}
+
+// Parse` + root + `Context parses the input as ` + root + `, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) Parse` + root + `Context(ctx context.Context, input []byte, limits peg.Limits) (` + returns + `, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.` + root + `()
+}
+
+// Parse` + root + `Reader parses the input read from the source as ` + root + `, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) Parse` + root + `Reader(source io.Reader) (` + returns + `, error) {
+	return parser.NewReaderSession(source).` + root + `()
+}
+`
 	}
-	file += "\n\t}\n}\n\n"
 
 	///////////////////////////
-	file += `
 
-type Parser struct {
-	input []byte
-	// Internal memoization tables`
+	if options.StackSafe {
+		file += state.machineSession(names, memo, options)
+	} else {
+		file += state.session(names, memo, options)
+	}
 
-	for i, definition := range state.Definitions {
+	for _, root := range exported {
+		definition := state.Definitions[state.Roots[root]]
 		file += `
-	where` + i + ` map[int]Result
-	what` + i + `  map[int]` + definition.Result
-		for _, resource := range definition.Resources {
-			file += "\nresource" + i + resource.Name + " " + resource.Type
+// ` + root + ` parses the whole input as ` + root + `. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) ` + root + `() (result ` + definition.Result + `, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.` + state.Roots[root] + `(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ` + root + `Prefix parses as much of the input as ` + root + ` matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for ` + root + `.
+func (session *Session) ` + root + `Prefix() (result ` + definition.Result + `, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.` + state.Roots[root] + `(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+`
+	}
+
+	for _, i := range names {
+		for _, resource := range state.Definitions[i].Resources {
+			if resource.Scope == GrammarScope {
+				file += "\nvar resource" + i + resource.Name + " " + resource.Type + " = " + resource.Expression
+			}
 		}
 	}
-	file += "}\n"
 
 	/////////////////////////////
 
+	if options.SelfContained {
+		file += "\n" + runtimeSource()
+	}
+
 	file += `
 
 // Below is the internal generated parse structure.
 // It's not very efficient right now, but is accomplishes parsing in linear time.
-// Currently, there's no way to parse multiple inputs, due to the fact that the
-// state of the parse is stored in global variables.
 
-type Result struct {
-	Ok       bool
-	At       int
-	Expected []Reject
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
 }
 
-type Reject interface {
-	Reason() string
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
 }
 
-func (r Result) Explain() string {
-	if r.Ok {
-		return fmt.Sprintf("Okay: %d characters parsed", r.At)
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
 	}
-	s := "Failed to parse. Expected at " + fmt.Sprintf("%d", r.At) + " one of:"
-	for _, v := range r.Expected {
-		s += "\n\t" + v.Reason()
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
 	}
-	return s
+	return nil
 }
 
-type Expected struct {
-	Token string
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
 }
 
-func (e Expected) Reason() string {
-	return fmt.Sprintf("%q", e.Token)
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
 }
 
-func Failure(tokens ...Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: tokens,
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
 	}
+	return here
 }
-func FailureCombined(first []Reject, second []Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: append(append([]Reject{}, first...), second...),
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
 	}
+	return session.fill(here, to)
 }
-func Success(at int) Result {
-	return Result{
-		Ok: true,
-		At: at,
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
 	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
 }
 
-type Exclude struct {
-	Message string
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
 }
 
-func (e Exclude) Reason() string {
-	return fmt.Sprintf("but not %s", e.Message)
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
 }
 `
 
-	names := []string{}
-	for key := range state.Definitions {
-		names = append(names, key)
+	if options.StackSafe {
+		machine, err := state.machine(exported, names, memo)
+		if err != nil {
+			return "", pruned, err
+		}
+		file += machine
+	} else {
+		for _, key := range names {
+			if !inline[key] {
+				file += state.function(key, options, memo, inline) + "\n"
+			}
+		}
 	}
-	sort.Strings(names)
-	for _, key := range names {
-		file += state.Definitions[key].Body + "\n"
+
+	if options.SelfContained {
+		file = unqualify(file)
+	}
+	if options.Prune {
+		var removed []string
+		var err error
+		if file, removed, err = pruneImports(file); err != nil {
+			return "", pruned, err
+		}
+		pruned.Imports = merge(pruned.Imports, removed)
 	}
+	if options.Prefix != "" || len(options.Names) != 0 {
+		file, err := rename(file, options)
+		return file, pruned, err
+	}
+	return file, pruned, nil
+}
 
+// session emits the Session type for recursive parsers, which holds a table
+// for each memoized definition.
+func (state *State) session(names []string, memo map[string]bool, options Options) string {
+	file := `
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset`
+	if options.Memo == MemoDense {
+		file += `
+	window   int  // The position of the first slot in each memoization table`
+	}
+	file += `
+	// Internal memoization tables`
+
+	for _, i := range names {
+		definition := state.Definitions[i]
+		if memo[i] {
+			switch options.Memo {
+			case MemoMaps:
+				file += `
+	where` + i + ` map[int]peg.Result
+	what` + i + `  map[int]` + definition.Result
+			case MemoDense:
+				file += `
+	slots` + i + ` []int32
+	memo` + i + `  []` + memoEntry(definition.Result)
+			}
+		}
+		for _, resource := range definition.Resources {
+			if resource.Scope == ParseScope {
+				file += "\n\tresource" + i + resource.Name + " " + resource.Type
+			}
+		}
+	}
+	file += "\n}\n"
+
+	file += `
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{`
+	for _, i := range names {
+		for _, resource := range state.Definitions[i].Resources {
+			if resource.Scope == ParseScope {
+				file += "\n\t\tresource" + i + resource.Name + ": " + resource.Expression + ","
+			}
+		}
+	}
+	file += `
+	}
+	session.Reset(input)
+	return session
+}
+` + sessionReader + `
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]`
+	if options.Memo == MemoDense {
+		file += `
+	session.window = 0`
+	}
+
+	for _, i := range names {
+		definition := state.Definitions[i]
+		if memo[i] {
+			switch options.Memo {
+			case MemoMaps:
+				file += `
+	if session.where` + i + ` == nil {
+		session.where` + i + ` = map[int]peg.Result{}
+		session.what` + i + ` = map[int]` + definition.Result + `{}
+	}
+	for key := range session.where` + i + ` {
+		delete(session.where` + i + `, key)
+		delete(session.what` + i + `, key)
+	}`
+			case MemoDense:
+				file += `
+	session.slots` + i + ` = session.emptySlots(session.slots` + i + `)
+	session.memo` + i + ` = session.memo` + i + `[:0]`
+			}
+		}
+	}
+	file += "\n}\n"
+
+	file += `
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.`
+	switch options.Memo {
+	case MemoMaps:
+		file += `
+func (session *Session) evict(before int, all bool) {
+	session.memos = 0`
+		for _, i := range names {
+			if memo[i] {
+				file += `
+	for key := range session.where` + i + ` {
+		if all || key < before && -1-key < before {
+			delete(session.where` + i + `, key)
+			delete(session.what` + i + `, key)
+		}
+	}
+	session.memos += len(session.where` + i + `)`
+			}
+		}
+	case MemoDense:
+		file += `
+func (session *Session) evict(before int, all bool) {
+	if before < session.window {
+		before = session.window
+	}
+	session.memos = 0`
+		for _, i := range names {
+			if memo[i] {
+				file += `
+	kept` + i + ` := session.memo` + i + `
+	session.memo` + i + ` = nil
+	for slot := range session.slots` + i + ` {
+		index := int32(0)
+		if from := slot + before - session.window; !all && from < len(session.slots` + i + `) && session.slots` + i + `[from] != 0 {
+			session.memo` + i + ` = append(session.memo` + i + `, kept` + i + `[session.slots` + i + `[from]-1])
+			index = int32(len(session.memo` + i + `))
+		}
+		session.slots` + i + `[slot] = index
+	}
+	session.memos += len(session.memo` + i + `)`
+			}
+		}
+		file += `
+	session.window = before`
+	}
+	file += "\n}\n"
+	if options.Memo == MemoDense {
+		file += denseSlots
+	}
 	return file
 }
+
+// denseSlots emits the methods which manage the slots of dense memoization
+// tables.
+const denseSlots = `
+// emptySlots returns a memoization table with an empty slot for each position
+// of the input read so far, reusing the memory of the given one. A slot holds
+// the index of the result memoized at its position plus one, or else zero.
+func (session *Session) emptySlots(table []int32) []int32 {
+	size := len(session.input) + 1
+	if cap(table) < size {
+		return make([]int32, size)
+	}
+	table = table[:size]
+	for slot := range table {
+		table[slot] = 0
+	}
+	return table
+}
+
+// slot is the slot of the table for the given position, which must not be
+// before the window. The table grows to hold it, if the input was streamed past
+// the end of the table.
+func (session *Session) slot(table *[]int32, here int) *int32 {
+	slot := here - session.window
+	if slot >= len(*table) {
+		grown := make([]int32, 2*slot+1)
+		copy(grown, *table)
+		*table = grown
+	}
+	return &(*table)[slot]
+}
+`
+
+// sessionReader emits the constructors for sessions which stream their input.
+const sessionReader = `
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+`
+
+// memoEntry is the type of a result in a dense memoization table, which is
+// silent if it was found inside a negative lookahead.
+func memoEntry(returns string) string {
+	return "struct { result peg.Result; value " + returns + "; silent bool }"
+}
+
+// plan decides which definitions are memoized, and which are inlined into the
+// only definition which uses them.
+func (state *State) plan(options Options) (memo map[string]bool, inline map[string]bool) {
+	uses := map[string]int{}
+	for _, definition := range state.Definitions {
+		for _, id := range definition.Uses {
+			uses[id]++
+		}
+	}
+	memo = map[string]bool{}
+	inline = map[string]bool{}
+	for id, definition := range state.Definitions {
+		switch options.Policy {
+		case MemoShared:
+			memo[id] = definition.Root || uses[id] > 1
+		case MemoEvery:
+			memo[id] = !definition.Root
+		}
+		if override, ok := state.Memo[id]; ok {
+			memo[id] = override
+		}
+		inline[id] = !memo[id] && !definition.Root && uses[id] == 1
+	}
+	return memo, inline
+}
+
+// body is the body of the function for the definition with the given ID, with
+// the definitions it uses inlined as function literals where planned. Inlined
+// definitions enforce the session's limits as their own functions would.
+func (state *State) body(name string, inline map[string]bool) string {
+	definition := state.Definitions[name]
+	body := definition.Body
+	for _, id := range definition.Uses {
+		if inline[id] {
+			body = strings.Replace(body, "session."+id+"(", `func(here int) (peg.Result, `+state.Definitions[id].Result+`) {
+	session.enter(here)
+	defer session.leave()`+strings.Replace(state.body(id, inline), "\n", "\n\t", -1)+"\n}(", -1)
+		}
+	}
+	return body
+}
+
+// function emits the function which parses the definition with the given ID,
+// along with one that enforces the session's limits and memoizes it if planned.
+// Results found inside a negative lookahead are memoized apart from the others,
+// since the failures within them weren't recorded: only another lookahead may
+// use them, and outside of one they're parsed again and replaced. The maps keep
+// them at the key -1-here.
+func (state *State) function(name string, options Options, memo map[string]bool, inline map[string]bool) string {
+	definition := state.Definitions[name]
+	returns := definition.Result
+	body := strings.Replace(state.body(name, inline), "\n", "\n\t", -1) + "\n}"
+	wrapper := `
+func (session *Session) ` + name + `(here int) (peg.Result, ` + returns + `) {
+	session.enter(here)
+	result, value := session.d` + name + `(here)
+	session.depth--
+	return result, value
+}`
+	if memo[name] {
+		switch options.Memo {
+		case MemoMaps:
+			wrapper = `
+func (session *Session) ` + name + `(here int) (peg.Result, ` + returns + `) {
+	if result, ok := session.where` + name + `[here]; ok {
+		return result, session.what` + name + `[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.where` + name + `[key]; ok {
+			return result, session.what` + name + `[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.d` + name + `(here)
+	session.depth--
+	session.where` + name + `[key] = result
+	session.what` + name + `[key] = value
+	session.count(here)
+	return result, value
+}`
+		case MemoDense:
+			wrapper = `
+func (session *Session) ` + name + `(here int) (peg.Result, ` + returns + `) {
+	if slot := here - session.window; slot >= 0 && slot < len(session.slots` + name + `) && session.slots` + name + `[slot] != 0 {
+		if memo := &session.memo` + name + `[session.slots` + name + `[slot]-1]; !memo.silent || session.failures.Silent != 0 {
+			return memo.result, memo.value
+		}
+	}
+	session.enter(here)
+	result, value := session.d` + name + `(here)
+	session.depth--
+	if here >= session.window {
+		session.memo` + name + ` = append(session.memo` + name + `, ` + memoEntry(returns) + `{result, value, session.failures.Silent != 0})
+		*session.slot(&session.slots` + name + `, here) = int32(len(session.memo` + name + `))
+		session.count(here)
+	}
+	return result, value
+}`
+		}
+	}
+	return wrapper + `
+
+// ` + definition.Detail + `
+func (session *Session) d` + name + `(here int) (peg.Result, ` + returns + `) {` + body
+}
@@ -0,0 +1,406 @@
+// Package typed builds grammars out of core.Peg nodes whose value types are
+// checked by the Go compiler. A Rule[T] is a node producing values of type T,
+// and the combinators only accept rules of the types they need:
+//
+//	grammar := typed.Grammar{State: &state, Package: "example.com/calc"}
+//	number := typed.Map[float64](typed.Regex(`[0-9]+`), "parse(arg)")
+//	sum := typed.Seq3(number, typed.Literal("+"), typed.Ref[float64]("sum"))
+//	_, err := typed.Define(grammar, "sum", typed.Choice(typed.Map[float64](sum, "arg.V0 + arg.V2"), number))
+//
+// The trees built are ordinary core.Peg trees, so they can be generated or
+// interpreted as usual. Go expressions in Map are still only checked when the
+// generated parser is compiled, and references made with Ref when they're
+// defined.
+//
+// Type names are found by reflection when a rule is defined. Named types from
+// the generated parser's own package, given by the Grammar, or from package
+// main are written unqualified, since they're declared alongside the parser;
+// those from other packages are qualified, and their packages are imported by
+// Define.
+package typed
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// Grammar is where typed rules are defined: the state, and the import path of
+// the package that its parser is generated into.
+type Grammar struct {
+	State   *core.State
+	Package string
+}
+
+// Rule is a grammar node whose values have type T. The node is built when the
+// rule is defined, once it's known which package it's written for.
+type Rule[T any] struct {
+	build func(local string, imports *[]string) core.Peg
+}
+
+// Peg is the core node built for the rule, written for a package other than
+// the ones declaring its types (except package main).
+func (rule Rule[T]) Peg() core.Peg {
+	return rule.build("", &[]string{})
+}
+
+func (rule Rule[T]) String() string {
+	return rule.Peg().String()
+}
+
+// Define defines the rule as a root of the grammar with the given name, and
+// returns a reference to it. It fails, defining nothing, if a reference to the
+// root, in this rule or one defined before it, expects a type other than T.
+func Define[T any](grammar Grammar, name string, rule Rule[T]) (Rule[T], error) {
+	imports := []string{}
+	peg := rule.build(grammar.Package, &imports)
+	if err := check(grammar.State, name, peg); err != nil {
+		return Rule[T]{}, err
+	}
+	grammar.State.AddImports(imports)
+	grammar.State.DefineRoot(name, peg)
+	return Ref[T](name), nil
+}
+
+// Ref refers to the root with the given name, which must be defined (perhaps
+// later) with a rule of the same type; Define checks that it is.
+func Ref[T any](name string) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		return core.Root{Name: name, Type: nameOf[T](local, imports)}
+	}}
+}
+
+// check finds the references which disagree with the type of the root about to
+// be defined with the given name and node: those in the node to roots already
+// defined, and those to the new root in the state. Each of the state's nodes is
+// a definition of its own, so it's enough to look at their children, and at
+// the roots defined as another root.
+func check(state *core.State, name string, peg core.Peg) error {
+	var err error
+	walk(peg, func(ref core.Root) {
+		root, ok := state.Definitions[state.Roots[ref.Name]]
+		if ok && root.Root && root.Result != ref.Type && err == nil {
+			err = mismatch(ref.Name, root.Result, ref.Type)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	defined := peg.TypeName()
+	id, ok := state.Roots[name]
+	if !ok {
+		return nil
+	}
+	for _, definition := range state.Definitions {
+		types := []string{}
+		if definition.Root && len(definition.Uses) == 1 && definition.Uses[0] == id {
+			types = append(types, definition.Result)
+		}
+		if definition.Node != nil {
+			for _, child := range children(definition.Node) {
+				if ref, ok := child.(core.Root); ok && ref.Name == name {
+					types = append(types, ref.Type)
+				}
+			}
+		}
+		for _, expected := range types {
+			if expected != defined {
+				return mismatch(name, defined, expected)
+			}
+		}
+	}
+	return nil
+}
+
+func mismatch(name, defined, referred string) error {
+	return fmt.Errorf("%s is defined with type %s, but referred to with type %s", name, defined, referred)
+}
+
+// walk calls found for each reference to a root in the node.
+func walk(peg core.Peg, found func(core.Root)) {
+	if ref, ok := peg.(core.Root); ok {
+		found(ref)
+	}
+	for _, child := range children(peg) {
+		walk(child, found)
+	}
+}
+
+// children are the nodes directly inside the node, as the rules build them,
+// looking through Memo nodes, which are defined as their arguments.
+func children(peg core.Peg) []core.Peg {
+	var nodes []core.Peg
+	switch peg := peg.(type) {
+	case core.Sequence:
+		nodes = append(nodes, peg...)
+	case core.Alternate:
+		nodes = append(nodes, peg...)
+	case core.Star:
+		nodes = []core.Peg{peg.Argument}
+	case core.Plus:
+		nodes = []core.Peg{peg.Argument}
+	case core.Optional:
+		nodes = []core.Peg{peg.Argument}
+	case core.Not:
+		nodes = []core.Peg{peg.Argument}
+	case core.And:
+		nodes = []core.Peg{peg.Argument}
+	case core.Contents:
+		nodes = []core.Peg{peg.Argument}
+	case core.Go:
+		nodes = []core.Peg{peg.Argument}
+	case core.Try:
+		nodes = []core.Peg{peg.Argument}
+	case core.Alias:
+		nodes = []core.Peg{peg.Argument}
+	case core.Label:
+		nodes = []core.Peg{peg.Argument}
+	case core.Recover:
+		nodes = []core.Peg{peg.Argument, peg.Until}
+	case core.Memo:
+		nodes = []core.Peg{peg.Argument}
+	}
+	for i, node := range nodes {
+		for {
+			memo, ok := node.(core.Memo)
+			if !ok {
+				break
+			}
+			node = memo.Argument
+		}
+		nodes[i] = node
+	}
+	return nodes
+}
+
+// node is a rule whose node is always the same.
+func node[T any](peg core.Peg) Rule[T] {
+	return Rule[T]{func(string, *[]string) core.Peg {
+		return peg
+	}}
+}
+
+// wrap is a rule whose node is built around the argument's node.
+func wrap[T, A any](rule Rule[A], build func(argument core.Peg) core.Peg) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		return build(rule.build(local, imports))
+	}}
+}
+
+func Literal(text string) Rule[string] {
+	return node[string](core.Literal(text))
+}
+
+func Regex(regex string) Rule[string] {
+	return node[string](core.Regex{Regex: regex})
+}
+
+// Contents matches the rule, and produces the text it matched.
+func Contents[T any](rule Rule[T]) Rule[string] {
+	return wrap[string](rule, func(argument core.Peg) core.Peg {
+		return core.Contents{Argument: argument}
+	})
+}
+
+// Choice tries each rule in turn, producing the value of the first to match.
+func Choice[T any](first Rule[T], rest ...Rule[T]) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		alternate := core.Alternate{first.build(local, imports)}
+		for _, rule := range rest {
+			alternate = append(alternate, rule.build(local, imports))
+		}
+		return alternate
+	}}
+}
+
+func Star[T any](rule Rule[T]) Rule[[]T] {
+	return wrap[[]T](rule, func(argument core.Peg) core.Peg {
+		return core.Star{Argument: argument}
+	})
+}
+
+func Plus[T any](rule Rule[T]) Rule[[]T] {
+	return wrap[[]T](rule, func(argument core.Peg) core.Peg {
+		return core.Plus{Argument: argument}
+	})
+}
+
+func Optional[T any](rule Rule[T]) Rule[*T] {
+	return wrap[*T](rule, func(argument core.Peg) core.Peg {
+		return core.Optional{Argument: argument}
+	})
+}
+
+func Not[T any](rule Rule[T]) Rule[struct{}] {
+	return wrap[struct{}](rule, func(argument core.Peg) core.Peg {
+		return core.Not{Argument: argument}
+	})
+}
+
+func And[T any](rule Rule[T]) Rule[T] {
+	return wrap[T](rule, func(argument core.Peg) core.Peg {
+		return core.And{Argument: argument}
+	})
+}
+
+// Cut commits the sequence containing it: if a later part of the sequence
+// fails, the parse fails there instead of trying other alternatives.
+func Cut() Rule[struct{}] {
+	return node[struct{}](core.Cut{})
+}
+
+// Map computes the rule's value with a Go expression of type T, in which arg
+// is the value of the rule being mapped.
+func Map[T any, A any](rule Rule[A], expression string) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		argument := rule.build(local, imports)
+		return core.Go{Argument: argument, Returns: nameOf[T](local, imports), Expression: expression}
+	}}
+}
+
+// TryMap is like Map, but the expression has the type (T, error). An error
+// fails the rule, and if fatal is set, the whole parse.
+func TryMap[T any, A any](rule Rule[A], expression string, fatal bool) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		argument := rule.build(local, imports)
+		return core.Try{Argument: argument, Returns: nameOf[T](local, imports), Expression: expression, Fatal: fatal}
+	}}
+}
+
+// Alias names the rule in error messages, in place of what its parts expected.
+func Alias[T any](rule Rule[T], name string) Rule[T] {
+	return wrap[T](rule, func(argument core.Peg) core.Peg {
+		return core.Alias{Argument: argument, Name: name}
+	})
+}
+
+// Label reports the message when the rule fails, in place of what it expected,
+// and fails the parse there. If opening is set, the message also says where
+// the enclosing sequence began.
+func Label[T any](rule Rule[T], message string, opening bool) Rule[T] {
+	return wrap[T](rule, func(argument core.Peg) core.Peg {
+		return core.Label{Argument: argument, Message: message, Opening: opening}
+	})
+}
+
+// Recover records the rule's failure and skips the input up to where until
+// matches, producing placeholder (a Go expression of type T, or the zero value
+// if empty) instead, so the parse can carry on and report several errors.
+func Recover[T any, U any](rule Rule[T], until Rule[U], placeholder string) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		return core.Recover{Argument: rule.build(local, imports), Until: until.build(local, imports), Placeholder: placeholder}
+	}}
+}
+
+// Memo decides whether the rule is memoized, regardless of the policy chosen
+// when the parser is generated.
+func Memo[T any](rule Rule[T], enabled bool) Rule[T] {
+	return wrap[T](rule, func(argument core.Peg) core.Peg {
+		return core.Memo{Argument: argument, Enabled: enabled}
+	})
+}
+
+func Seq2[A, B any](a Rule[A], b Rule[B]) Rule[struct {
+	V0 A
+	V1 B
+}] {
+	return sequence[struct {
+		V0 A
+		V1 B
+	}](a.build, b.build)
+}
+
+func Seq3[A, B, C any](a Rule[A], b Rule[B], c Rule[C]) Rule[struct {
+	V0 A
+	V1 B
+	V2 C
+}] {
+	return sequence[struct {
+		V0 A
+		V1 B
+		V2 C
+	}](a.build, b.build, c.build)
+}
+
+func Seq4[A, B, C, D any](a Rule[A], b Rule[B], c Rule[C], d Rule[D]) Rule[struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+}] {
+	return sequence[struct {
+		V0 A
+		V1 B
+		V2 C
+		V3 D
+	}](a.build, b.build, c.build, d.build)
+}
+
+func Seq5[A, B, C, D, E any](a Rule[A], b Rule[B], c Rule[C], d Rule[D], e Rule[E]) Rule[struct {
+	V0 A
+	V1 B
+	V2 C
+	V3 D
+	V4 E
+}] {
+	return sequence[struct {
+		V0 A
+		V1 B
+		V2 C
+		V3 D
+		V4 E
+	}](a.build, b.build, c.build, d.build, e.build)
+}
+
+// sequence is a rule whose node is a Sequence of the nodes that the builds
+// make, in order.
+func sequence[T any](builds ...func(string, *[]string) core.Peg) Rule[T] {
+	return Rule[T]{func(local string, imports *[]string) core.Peg {
+		nodes := core.Sequence{}
+		for _, build := range builds {
+			nodes = append(nodes, build(local, imports))
+		}
+		return nodes
+	}}
+}
+
+// nameOf is how T is written in a parser generated into the package with the
+// import path local, adding the packages which must be imported for it.
+func nameOf[T any](local string, imports *[]string) string {
+	return name(reflect.TypeOf((*T)(nil)).Elem(), local, imports)
+}
+
+func name(t reflect.Type, local string, imports *[]string) string {
+	if t.Name() != "" {
+		switch t.PkgPath() {
+		case "", "main", local:
+			return t.Name()
+		}
+		*imports = append(*imports, t.PkgPath())
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return "*" + name(t.Elem(), local, imports)
+	case reflect.Slice:
+		return "[]" + name(t.Elem(), local, imports)
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), name(t.Elem(), local, imports))
+	case reflect.Map:
+		return "map[" + name(t.Key(), local, imports) + "]" + name(t.Elem(), local, imports)
+	case reflect.Struct:
+		fields := []string{}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Anonymous {
+				fields = append(fields, name(field.Type, local, imports)+";")
+			} else {
+				fields = append(fields, field.Name+" "+name(field.Type, local, imports)+";")
+			}
+		}
+		return "struct{" + strings.Join(fields, "") + "}"
+	}
+	return t.String()
+}
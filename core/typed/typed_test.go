@@ -0,0 +1,171 @@
+package typed_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+	"github.com/nathan-fenner/go-peg-tree/core/typed"
+)
+
+// The README's grammar, with a few more kinds of rule, builds the same state
+// through typed rules as written out by hand.
+func TestSameGrammar(t *testing.T) {
+	built := core.NewState()
+	grammar := typed.Grammar{State: &built, Package: "example.com/calc"}
+	number, err := typed.Define(grammar, "number", typed.Alias(typed.TryMap[float64](typed.Regex(`[0-9]+`), "strconv.ParseFloat(arg, 64)", false), "number"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := typed.Seq3(number, typed.Literal("+"), typed.Ref[float64]("sum"))
+	if _, err := typed.Define(grammar, "sum", typed.Choice(typed.Map[float64](sum, "arg.V0 + arg.V2"), number)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := typed.Define(grammar, "list", typed.Star(typed.Seq2(typed.Not(typed.Literal(";")), typed.Optional(typed.Contents(typed.Ref[float64]("sum")))))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := typed.Define(grammar, "wait", typed.Map[time.Duration](typed.Label(typed.Literal("s"), "missing unit", true), "time.Second")); err != nil {
+		t.Fatal(err)
+	}
+
+	written := core.NewState()
+	written.DefineRoot("number", core.Alias{Argument: core.Try{
+		Argument:   core.Regex{Regex: `[0-9]+`},
+		Returns:    "float64",
+		Expression: "strconv.ParseFloat(arg, 64)",
+	}, Name: "number"})
+	numberRoot := core.Root{Name: "number", Type: "float64"}
+	written.DefineRoot("sum", core.Alternate{
+		core.Go{
+			Argument:   core.Sequence{numberRoot, core.Literal("+"), core.Root{Name: "sum", Type: "float64"}},
+			Returns:    "float64",
+			Expression: "arg.V0 + arg.V2",
+		},
+		numberRoot,
+	})
+	written.DefineRoot("list", core.Star{Argument: core.Sequence{
+		core.Not{Argument: core.Literal(";")},
+		core.Optional{Argument: core.Contents{Argument: core.Root{Name: "sum", Type: "float64"}}},
+	}})
+	written.AddImport("time")
+	written.DefineRoot("wait", core.Go{
+		Argument:   core.Label{Argument: core.Literal("s"), Message: "missing unit", Opening: true},
+		Returns:    "time.Duration",
+		Expression: "time.Second",
+	})
+
+	if !reflect.DeepEqual(built, written) {
+		t.Errorf("got %+v, want %+v", built, written)
+	}
+	got, err := built.Generate("parse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := written.Generate("parse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("generated parsers differ:\n%s\nwant\n%s", got, want)
+	}
+}
+
+// Each rule's TypeName is how its Go type is written.
+func TestTypeName(t *testing.T) {
+	sum := typed.Seq3(typed.Ref[float64]("number"), typed.Literal("+"), typed.Ref[float64]("sum"))
+	for _, test := range []struct {
+		rule core.Peg
+		want string
+	}{
+		{sum.Peg(), typed.Ref[struct {
+			V0 float64
+			V1 string
+			V2 float64
+		}]("sum").Peg().TypeName()},
+		{sum.Peg(), "struct{V0 float64;V1 string;V2 float64;}"},
+		{typed.Star(typed.Literal("a")).Peg(), "[]string"},
+		{typed.Optional(typed.Ref[[]int]("ints")).Peg(), "*[]int"},
+		{typed.Not(typed.Literal("a")).Peg(), "struct{}"},
+		{typed.Map[map[string]time.Duration](typed.Literal("a"), "nil").Peg(), "map[string]time.Duration"},
+		{typed.Ref[[2]*time.Time]("times").Peg(), "[2]*time.Time"},
+	} {
+		if got := test.rule.TypeName(); got != test.want {
+			t.Errorf("%s: got %q, want %q", test.rule, got, test.want)
+		}
+	}
+}
+
+type point struct{ X, Y int }
+
+// Types declared in the package that the parser is generated into are written
+// unqualified, and that package isn't imported, since it can't import itself.
+func TestLocalTypes(t *testing.T) {
+	local := reflect.TypeOf(point{}).PkgPath()
+	for _, test := range []struct {
+		path    string
+		want    string
+		imports []string
+	}{
+		{local, "[]point", []string{"time"}},
+		{"example.com/elsewhere", "[]typed_test.point", []string{"time", local}},
+	} {
+		state := core.NewState()
+		base := len(state.Imports)
+		if _, err := typed.Define(typed.Grammar{State: &state, Package: test.path}, "points", typed.Map[[]point](typed.Ref[time.Duration]("wait"), "nil")); err != nil {
+			t.Fatal(err)
+		}
+		for _, definition := range state.Definitions {
+			if node, ok := definition.Node.(core.Go); ok && node.Returns != test.want {
+				t.Errorf("in %s: got %q, want %q", test.path, node.Returns, test.want)
+			}
+		}
+		if imports := state.Imports[base:]; !reflect.DeepEqual(imports, test.imports) {
+			t.Errorf("in %s: imported %q, want %q", test.path, imports, test.imports)
+		}
+	}
+}
+
+// A reference to a root with a type other than the root's own is an error,
+// whether the root is defined before the reference or after it, and the rule
+// that Define rejects isn't defined.
+func TestRefTypes(t *testing.T) {
+	number := typed.Map[float64](typed.Regex(`[0-9]+`), "parse(arg)")
+	for _, test := range []struct {
+		name     string
+		define   func(grammar typed.Grammar) error
+		rejected string
+		want     string
+	}{
+		{"defined before", func(grammar typed.Grammar) error {
+			if _, err := typed.Define(grammar, "number", number); err != nil {
+				return err
+			}
+			_, err := typed.Define(grammar, "sum", typed.Seq2(typed.Ref[int]("number"), typed.Literal("+")))
+			return err
+		}, "sum", "number is defined with type float64, but referred to with type int"},
+		{"defined after", func(grammar typed.Grammar) error {
+			if _, err := typed.Define(grammar, "sum", typed.Optional(typed.Memo(typed.Ref[string]("number"), true))); err != nil {
+				return err
+			}
+			_, err := typed.Define(grammar, "number", number)
+			return err
+		}, "number", "number is defined with type float64, but referred to with type string"},
+		{"defined as another root", func(grammar typed.Grammar) error {
+			if _, err := typed.Define(grammar, "value", typed.Ref[[]float64]("number")); err != nil {
+				return err
+			}
+			_, err := typed.Define(grammar, "number", number)
+			return err
+		}, "number", "number is defined with type float64, but referred to with type []float64"},
+	} {
+		state := core.NewState()
+		err := test.define(typed.Grammar{State: &state})
+		if err == nil || err.Error() != test.want {
+			t.Errorf("%s: got %v, not %q", test.name, err, test.want)
+		}
+		if _, ok := state.Definitions[state.Roots[test.rejected]]; ok {
+			t.Errorf("%s: %s was defined anyway", test.name, test.rejected)
+		}
+	}
+}
@@ -1,574 +1,873 @@
 package arithmetic
 
-import "fmt"
+import "context"
+import "errors"
+import "io"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
 
-func (parser Parser) Expression(input string) (float64, error) {
-	check, value := parser.m19([]rune(input), 0)
-	if check.Ok {
-		return value, nil
-	}
-	var zero float64
-	return zero, fmt.Errorf("%s", check.Explain())
-}
-
-func NewParser(input string) Parser {
-	return Parser{
-		input:    []rune(input),
-		wherem13: map[int]Result{},
-		whatm13:  map[int]float64{},
-		wherem18: map[int]Result{},
-		whatm18:  map[int]float64{},
-		wherem9:  map[int]Result{},
-		whatm9:   map[int]float64{},
-		wherem12: map[int]Result{},
-		whatm12:  map[int]float64{},
-		wherem16: map[int]Result{},
-		whatm16: map[int]struct {
-			V0 float64
-			V1 string
-			V2 float64
-		}{},
-		wherem2:  map[int]Result{},
-		whatm2:   map[int]float64{},
-		wherem0:  map[int]Result{},
-		whatm0:   map[int]float64{},
-		wherem3:  map[int]Result{},
-		whatm3:   map[int]float64{},
-		wherem10: map[int]Result{},
-		whatm10:  map[int]string{},
-		wherem11: map[int]Result{},
-		whatm11:  map[int]float64{},
-		wherem19: map[int]Result{},
-		whatm19:  map[int]float64{},
-		wherem4:  map[int]Result{},
-		whatm4:   map[int]string{},
-		wherem7:  map[int]Result{},
-		whatm7:   map[int]string{},
-		wherem8:  map[int]Result{},
-		whatm8:   map[int]float64{},
-		wherem15: map[int]Result{},
-		whatm15:  map[int]string{},
-		wherem17: map[int]Result{},
-		whatm17:  map[int]float64{},
-		wherem14: map[int]Result{},
-		whatm14:  map[int]float64{},
-		wherem1:  map[int]Result{},
-		whatm1:   map[int]string{},
-		wherem5:  map[int]Result{},
-		whatm5:   map[int]float64{},
-		wherem6:  map[int]Result{},
-		whatm6:   map[int]float64{},
-	}
-}
-
-type Parser struct {
-	input []rune
-	// Internal memoization tables
-	wherem13 map[int]Result
-	whatm13  map[int]float64
-	wherem18 map[int]Result
-	whatm18  map[int]float64
-	wherem2  map[int]Result
-	whatm2   map[int]float64
-	wherem0  map[int]Result
-	whatm0   map[int]float64
-	wherem3  map[int]Result
-	whatm3   map[int]float64
-	wherem9  map[int]Result
-	whatm9   map[int]float64
-	wherem12 map[int]Result
-	whatm12  map[int]float64
-	wherem16 map[int]Result
-	whatm16  map[int]struct {
-		V0 float64
-		V1 string
-		V2 float64
-	}
-	wherem4  map[int]Result
-	whatm4   map[int]string
-	wherem7  map[int]Result
-	whatm7   map[int]string
-	wherem8  map[int]Result
-	whatm8   map[int]float64
-	wherem10 map[int]Result
-	whatm10  map[int]string
-	wherem11 map[int]Result
-	whatm11  map[int]float64
-	wherem19 map[int]Result
-	whatm19  map[int]float64
-	wherem1  map[int]Result
-	whatm1   map[int]string
-	wherem5  map[int]Result
-	whatm5   map[int]float64
-	wherem6  map[int]Result
-	whatm6   map[int]float64
-	wherem15 map[int]Result
-	whatm15  map[int]string
-	wherem17 map[int]Result
-	whatm17  map[int]float64
-	wherem14 map[int]Result
-	whatm14  map[int]float64
-}
-
-// Below is the internal generated parse structure.
-// It's not very efficient right now, but is accomplishes parsing in linear time.
-// Currently, there's no way to parse multiple inputs, due to the fact that the
-// state of the parse is stored in global variables.
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
 
-type Result struct {
-	Ok       bool
-	At       int
-	Expected []Reject
+func NewParser() Parser {
+	return Parser{}
 }
 
-type Reject interface {
-	Reason() string
+// ParseExpression parses the whole input as Expression, in a fresh session.
+func (parser Parser) ParseExpression(input []byte) (float64, error) {
+	return parser.NewSession(input).Expression()
 }
 
-func (r Result) Explain() string {
-	if r.Ok {
-		return fmt.Sprintf("Okay: %d characters parsed", r.At)
-	}
-	s := "Failed to parse. Expected at " + fmt.Sprintf("%d", r.At) + " one of:"
-	for _, v := range r.Expected {
-		s += "\n\t" + v.Reason()
-	}
-	return s
+// ParseExpressionPrefix parses as much of the input as Expression matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseExpressionPrefix(input []byte) (float64, int, error) {
+	return parser.NewSession(input).ExpressionPrefix()
 }
 
-type Expected struct {
-	Token string
+// ParseExpressionContext parses the input as Expression, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseExpressionContext(ctx context.Context, input []byte, limits peg.Limits) (float64, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Expression()
 }
 
-func (e Expected) Reason() string {
-	return fmt.Sprintf("%q", e.Token)
+// ParseExpressionReader parses the input read from the source as Expression, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseExpressionReader(source io.Reader) (float64, error) {
+	return parser.NewReaderSession(source).Expression()
 }
 
-func Failure(tokens ...Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: tokens,
-	}
-}
-func FailureCombined(first []Reject, second []Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: append(append([]Reject{}, first...), second...),
-	}
-}
-func Success(at int) Result {
-	return Result{
-		Ok: true,
-		At: at,
-	}
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
+	// Internal memoization tables
+	wherem_Expression map[int]peg.Result
+	whatm_Expression  map[int]float64
+	wherem_four       map[int]peg.Result
+	whatm_four        map[int]float64
+	wherem_number     map[int]peg.Result
+	whatm_number      map[int]float64
+	wherem_one        map[int]peg.Result
+	whatm_one         map[int]float64
+	wherem_sum        map[int]peg.Result
+	whatm_sum         map[int]float64
+	wherem_three      map[int]peg.Result
+	whatm_three       map[int]float64
+	wherem_two        map[int]peg.Result
+	whatm_two         map[int]float64
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.wherem_Expression == nil {
+		session.wherem_Expression = map[int]peg.Result{}
+		session.whatm_Expression = map[int]float64{}
+	}
+	for key := range session.wherem_Expression {
+		delete(session.wherem_Expression, key)
+		delete(session.whatm_Expression, key)
+	}
+	if session.wherem_four == nil {
+		session.wherem_four = map[int]peg.Result{}
+		session.whatm_four = map[int]float64{}
+	}
+	for key := range session.wherem_four {
+		delete(session.wherem_four, key)
+		delete(session.whatm_four, key)
+	}
+	if session.wherem_number == nil {
+		session.wherem_number = map[int]peg.Result{}
+		session.whatm_number = map[int]float64{}
+	}
+	for key := range session.wherem_number {
+		delete(session.wherem_number, key)
+		delete(session.whatm_number, key)
+	}
+	if session.wherem_one == nil {
+		session.wherem_one = map[int]peg.Result{}
+		session.whatm_one = map[int]float64{}
+	}
+	for key := range session.wherem_one {
+		delete(session.wherem_one, key)
+		delete(session.whatm_one, key)
+	}
+	if session.wherem_sum == nil {
+		session.wherem_sum = map[int]peg.Result{}
+		session.whatm_sum = map[int]float64{}
+	}
+	for key := range session.wherem_sum {
+		delete(session.wherem_sum, key)
+		delete(session.whatm_sum, key)
+	}
+	if session.wherem_three == nil {
+		session.wherem_three = map[int]peg.Result{}
+		session.whatm_three = map[int]float64{}
+	}
+	for key := range session.wherem_three {
+		delete(session.wherem_three, key)
+		delete(session.whatm_three, key)
+	}
+	if session.wherem_two == nil {
+		session.wherem_two = map[int]peg.Result{}
+		session.whatm_two = map[int]float64{}
+	}
+	for key := range session.wherem_two {
+		delete(session.wherem_two, key)
+		delete(session.whatm_two, key)
+	}
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	session.memos = 0
+	for key := range session.wherem_Expression {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Expression, key)
+			delete(session.whatm_Expression, key)
+		}
+	}
+	session.memos += len(session.wherem_Expression)
+	for key := range session.wherem_four {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_four, key)
+			delete(session.whatm_four, key)
+		}
+	}
+	session.memos += len(session.wherem_four)
+	for key := range session.wherem_number {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_number, key)
+			delete(session.whatm_number, key)
+		}
+	}
+	session.memos += len(session.wherem_number)
+	for key := range session.wherem_one {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_one, key)
+			delete(session.whatm_one, key)
+		}
+	}
+	session.memos += len(session.wherem_one)
+	for key := range session.wherem_sum {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_sum, key)
+			delete(session.whatm_sum, key)
+		}
+	}
+	session.memos += len(session.wherem_sum)
+	for key := range session.wherem_three {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_three, key)
+			delete(session.whatm_three, key)
+		}
+	}
+	session.memos += len(session.wherem_three)
+	for key := range session.wherem_two {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_two, key)
+			delete(session.whatm_two, key)
+		}
+	}
+	session.memos += len(session.wherem_two)
+}
+
+// Expression parses the whole input as Expression. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Expression() (result float64, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Expression(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// ExpressionPrefix parses as much of the input as Expression matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Expression.
+func (session *Session) ExpressionPrefix() (result float64, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Expression(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
 }
 
-type Exclude struct {
-	Message string
-}
+// Below is the internal generated parse structure.
+// It's not very efficient right now, but is accomplishes parsing in linear time.
 
-func (e Exclude) Reason() string {
-	return fmt.Sprintf("but not %s", e.Message)
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
 }
 
-func (parser Parser) m0(input []rune, here int) (Result, float64) {
-	return parser.m2(input, here)
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
 }
 
-var wherem1 = map[int]Result{}
-var whatm1 = map[int]string{}
-
-func (parser Parser) m1(input []rune, here int) (Result, string) {
-	if result, ok := parser.wherem1[here]; ok {
-		return result, parser.whatm1[here]
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
 	}
-	result, value := parser.dm1(input, here)
-	parser.wherem1[here] = result
-	parser.whatm1[here] = value
-	return result, value
-}
-
-// "one"
-func (parser Parser) dm1(input []rune, here int) (Result, string) {
-	if here+3 > len(input) || string(input[here:here+3]) != "one" {
-		return Failure(Expected{Token: "one"}), ""
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
 	}
-	return Success(here + 3), "one"
+	return nil
 }
 
-var wherem10 = map[int]Result{}
-var whatm10 = map[int]string{}
-
-func (parser Parser) m10(input []rune, here int) (Result, string) {
-	if result, ok := parser.wherem10[here]; ok {
-		return result, parser.whatm10[here]
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
 	}
-	result, value := parser.dm10(input, here)
-	parser.wherem10[here] = result
-	parser.whatm10[here] = value
-	return result, value
+	session.parsed = true
+	return session.start(len(session.input))
 }
 
-// "four"
-func (parser Parser) dm10(input []rune, here int) (Result, string) {
-	if here+4 > len(input) || string(input[here:here+4]) != "four" {
-		return Failure(Expected{Token: "four"}), ""
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
 	}
-	return Success(here + 4), "four"
-}
-
-var wherem11 = map[int]Result{}
-var whatm11 = map[int]float64{}
-
-func (parser Parser) m11(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem11[here]; ok {
-		return result, parser.whatm11[here]
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
 	}
-	result, value := parser.dm11(input, here)
-	parser.wherem11[here] = result
-	parser.whatm11[here] = value
-	return result, value
-}
-
-// string go { float64 }
-func (parser Parser) dm11(input []rune, here int) (Result, float64) {
-	check, value := parser.m10(input, here)
-	if !check.Ok {
-		var zero float64
-		return check, zero
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
 	}
-	answer := func(arg string) float64 {
-		return 4
-	}(value)
-	return check, answer
 }
 
-func (parser Parser) m12(input []rune, here int) (Result, float64) {
-	return parser.m13(input, here)
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
 }
 
-var wherem13 = map[int]Result{}
-var whatm13 = map[int]float64{}
-
-func (parser Parser) m13(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem13[here]; ok {
-		return result, parser.whatm13[here]
-	}
-	result, value := parser.dm13(input, here)
-	parser.wherem13[here] = result
-	parser.whatm13[here] = value
-	return result, value
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
 }
 
-// (root one / root two / root three / root four)
-func (parser Parser) dm13(input []rune, here int) (Result, float64) {
-	notes := []Reject{}
-	if next, value := parser.m0(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
-	}
-	if next, value := parser.m3(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
-	}
-	if next, value := parser.m6(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
-	}
-	if next, value := parser.m9(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
-	}
-	var zero float64
-	return Failure(notes...), zero
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
 }
 
-func (parser Parser) m14(input []rune, here int) (Result, float64) {
-	return parser.m18(input, here)
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
 }
 
-var wherem15 = map[int]Result{}
-var whatm15 = map[int]string{}
-
-func (parser Parser) m15(input []rune, here int) (Result, string) {
-	if result, ok := parser.wherem15[here]; ok {
-		return result, parser.whatm15[here]
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
 	}
-	result, value := parser.dm15(input, here)
-	parser.wherem15[here] = result
-	parser.whatm15[here] = value
-	return result, value
+	return here
 }
 
-// "+"
-func (parser Parser) dm15(input []rune, here int) (Result, string) {
-	if here+1 > len(input) || string(input[here:here+1]) != "+" {
-		return Failure(Expected{Token: "+"}), ""
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
 	}
-	return Success(here + 1), "+"
 }
 
-var wherem16 = map[int]Result{}
-var whatm16 = map[int]struct {
-	V0 float64
-	V1 string
-	V2 float64
-}{}
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
 
-func (parser Parser) m16(input []rune, here int) (Result, struct {
-	V0 float64
-	V1 string
-	V2 float64
-}) {
-	if result, ok := parser.wherem16[here]; ok {
-		return result, parser.whatm16[here]
-	}
-	result, value := parser.dm16(input, here)
-	parser.wherem16[here] = result
-	parser.whatm16[here] = value
-	return result, value
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
 }
 
-// root number "+" root sum
-func (parser Parser) dm16(input []rune, here int) (Result, struct {
-	V0 float64
-	V1 string
-	V2 float64
-}) {
-	result := struct {
-		V0 float64
-		V1 string
-		V2 float64
-	}{}
-	if next, value := parser.m12(input, here); next.Ok {
-		here = next.At
-		result.V0 = value
-	} else {
-		return next, struct {
-			V0 float64
-			V1 string
-			V2 float64
-		}{}
-	}
-	if next, value := parser.m15(input, here); next.Ok {
-		here = next.At
-		result.V1 = value
-	} else {
-		return next, struct {
-			V0 float64
-			V1 string
-			V2 float64
-		}{}
-	}
-	if next, value := parser.m14(input, here); next.Ok {
-		here = next.At
-		result.V2 = value
-	} else {
-		return next, struct {
-			V0 float64
-			V1 string
-			V2 float64
-		}{}
-	}
-	return Success(here), result
-}
-
-var wherem17 = map[int]Result{}
-var whatm17 = map[int]float64{}
-
-func (parser Parser) m17(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem17[here]; ok {
-		return result, parser.whatm17[here]
-	}
-	result, value := parser.dm17(input, here)
-	parser.wherem17[here] = result
-	parser.whatm17[here] = value
-	return result, value
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
 }
 
-// struct{V0 float64;V1 string;V2 float64;} go { float64 }
-func (parser Parser) dm17(input []rune, here int) (Result, float64) {
-	check, value := parser.m16(input, here)
-	if !check.Ok {
-		var zero float64
-		return check, zero
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
 	}
-	answer := func(arg struct {
-		V0 float64
-		V1 string
-		V2 float64
-	}) float64 {
-		return arg.V0 + arg.V2
-	}(value)
-	return check, answer
+	return here
 }
 
-var wherem18 = map[int]Result{}
-var whatm18 = map[int]float64{}
-
-func (parser Parser) m18(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem18[here]; ok {
-		return result, parser.whatm18[here]
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
 	}
-	result, value := parser.dm18(input, here)
-	parser.wherem18[here] = result
-	parser.whatm18[here] = value
-	return result, value
+	return session.fill(here, to)
 }
 
-// (struct{V0 float64;V1 string;V2 float64;} go { float64 } / root number)
-func (parser Parser) dm18(input []rune, here int) (Result, float64) {
-	notes := []Reject{}
-	if next, value := parser.m17(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
 	}
-	if next, value := parser.m12(input, here); next.Ok {
-		return next, value
-	} else {
-		notes = append(notes, next.Expected...)
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
 	}
-	var zero float64
-	return Failure(notes...), zero
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
 }
 
-func (parser Parser) m19(input []rune, here int) (Result, float64) {
-	return parser.m14(input, here)
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
 }
 
-var wherem2 = map[int]Result{}
-var whatm2 = map[int]float64{}
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
+}
 
-func (parser Parser) m2(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem2[here]; ok {
-		return result, parser.whatm2[here]
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
 	}
-	result, value := parser.dm2(input, here)
-	parser.wherem2[here] = result
-	parser.whatm2[here] = value
-	return result, value
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
 }
 
-// string go { float64 }
-func (parser Parser) dm2(input []rune, here int) (Result, float64) {
-	check, value := parser.m1(input, here)
-	if !check.Ok {
-		var zero float64
-		return check, zero
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
 	}
-	answer := func(arg string) float64 {
-		return 1
-	}(value)
-	return check, answer
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
 }
 
-func (parser Parser) m3(input []rune, here int) (Result, float64) {
-	return parser.m5(input, here)
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
 }
 
-var wherem4 = map[int]Result{}
-var whatm4 = map[int]string{}
-
-func (parser Parser) m4(input []rune, here int) (Result, string) {
-	if result, ok := parser.wherem4[here]; ok {
-		return result, parser.whatm4[here]
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
 	}
-	result, value := parser.dm4(input, here)
-	parser.wherem4[here] = result
-	parser.whatm4[here] = value
-	return result, value
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
 }
 
-// "two"
-func (parser Parser) dm4(input []rune, here int) (Result, string) {
-	if here+3 > len(input) || string(input[here:here+3]) != "two" {
-		return Failure(Expected{Token: "two"}), ""
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
 	}
-	return Success(here + 3), "two"
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
 }
 
-var wherem5 = map[int]Result{}
-var whatm5 = map[int]float64{}
-
-func (parser Parser) m5(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem5[here]; ok {
-		return result, parser.whatm5[here]
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
 	}
-	result, value := parser.dm5(input, here)
-	parser.wherem5[here] = result
-	parser.whatm5[here] = value
-	return result, value
 }
 
-// string go { float64 }
-func (parser Parser) dm5(input []rune, here int) (Result, float64) {
-	check, value := parser.m4(input, here)
-	if !check.Ok {
-		var zero float64
-		return check, zero
+func (session *Session) m_Expression(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_Expression[here]; ok {
+		return result, session.whatm_Expression[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Expression[key]; ok {
+			return result, session.whatm_Expression[key]
+		}
 	}
-	answer := func(arg string) float64 {
-		return 2
-	}(value)
-	return check, answer
+	session.enter(here)
+	result, value := session.dm_Expression(here)
+	session.depth--
+	session.wherem_Expression[key] = result
+	session.whatm_Expression[key] = value
+	session.count(here)
+	return result, value
 }
 
-func (parser Parser) m6(input []rune, here int) (Result, float64) {
-	return parser.m8(input, here)
+// root Expression
+func (session *Session) dm_Expression(here int) (peg.Result, float64) {
+	return session.m_sum(here)
 }
 
-var wherem7 = map[int]Result{}
-var whatm7 = map[int]string{}
-
-func (parser Parser) m7(input []rune, here int) (Result, string) {
-	if result, ok := parser.wherem7[here]; ok {
-		return result, parser.whatm7[here]
+func (session *Session) m_four(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_four[here]; ok {
+		return result, session.whatm_four[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_four[key]; ok {
+			return result, session.whatm_four[key]
+		}
 	}
-	result, value := parser.dm7(input, here)
-	parser.wherem7[here] = result
-	parser.whatm7[here] = value
+	session.enter(here)
+	result, value := session.dm_four(here)
+	session.depth--
+	session.wherem_four[key] = result
+	session.whatm_four[key] = value
+	session.count(here)
 	return result, value
 }
 
-// "three"
-func (parser Parser) dm7(input []rune, here int) (Result, string) {
-	if here+5 > len(input) || string(input[here:here+5]) != "three" {
-		return Failure(Expected{Token: "three"}), ""
-	}
-	return Success(here + 5), "three"
+// root four
+func (session *Session) dm_four(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+4) || string(session.slice(here, here+4)) != "four" {
+				return session.failures.Fail(here, peg.Expected{Token: "four"}), ""
+			}
+			return peg.Success(here + 4), "four"
+		}(here)
+		if !check.Ok {
+			var zero float64
+			return check, zero
+		}
+		answer := func(arg string) float64 {
+			return 4
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_number(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_number[here]; ok {
+		return result, session.whatm_number[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_number[key]; ok {
+			return result, session.whatm_number[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_number(here)
+	session.depth--
+	session.wherem_number[key] = result
+	session.whatm_number[key] = value
+	session.count(here)
+	return result, value
 }
 
-var wherem8 = map[int]Result{}
-var whatm8 = map[int]float64{}
+// root number
+func (session *Session) dm_number(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := session.m_one(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_two(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_three(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_four(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero float64
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_one(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_one[here]; ok {
+		return result, session.whatm_one[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_one[key]; ok {
+			return result, session.whatm_one[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_one(here)
+	session.depth--
+	session.wherem_one[key] = result
+	session.whatm_one[key] = value
+	session.count(here)
+	return result, value
+}
 
-func (parser Parser) m8(input []rune, here int) (Result, float64) {
-	if result, ok := parser.wherem8[here]; ok {
-		return result, parser.whatm8[here]
-	}
-	result, value := parser.dm8(input, here)
-	parser.wherem8[here] = result
-	parser.whatm8[here] = value
+// root one
+func (session *Session) dm_one(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+3) || string(session.slice(here, here+3)) != "one" {
+				return session.failures.Fail(here, peg.Expected{Token: "one"}), ""
+			}
+			return peg.Success(here + 3), "one"
+		}(here)
+		if !check.Ok {
+			var zero float64
+			return check, zero
+		}
+		answer := func(arg string) float64 {
+			return 1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_sum(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_sum[here]; ok {
+		return result, session.whatm_sum[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_sum[key]; ok {
+			return result, session.whatm_sum[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_sum(here)
+	session.depth--
+	session.wherem_sum[key] = result
+	session.whatm_sum[key] = value
+	session.count(here)
 	return result, value
 }
 
-// string go { float64 }
-func (parser Parser) dm8(input []rune, here int) (Result, float64) {
-	check, value := parser.m7(input, here)
-	if !check.Ok {
+// root sum
+func (session *Session) dm_sum(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := func(here int) (peg.Result, float64) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 float64
+				V1 string
+				V2 float64
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 float64
+					V1 string
+					V2 float64
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_number(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 float64
+						V1 string
+						V2 float64
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "+" {
+						return session.failures.Fail(here, peg.Expected{Token: "+"}), ""
+					}
+					return peg.Success(here + 1), "+"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 float64
+						V1 string
+						V2 float64
+					}{}
+				}
+				if next, value := session.m_sum(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 float64
+						V1 string
+						V2 float64
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero float64
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 float64
+				V1 string
+				V2 float64
+			}) float64 {
+				return arg.V0 + arg.V2
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_number(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
 		var zero float64
-		return check, zero
-	}
-	answer := func(arg string) float64 {
-		return 3
-	}(value)
-	return check, answer
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_three(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_three[here]; ok {
+		return result, session.whatm_three[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_three[key]; ok {
+			return result, session.whatm_three[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_three(here)
+	session.depth--
+	session.wherem_three[key] = result
+	session.whatm_three[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root three
+func (session *Session) dm_three(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+5) || string(session.slice(here, here+5)) != "three" {
+				return session.failures.Fail(here, peg.Expected{Token: "three"}), ""
+			}
+			return peg.Success(here + 5), "three"
+		}(here)
+		if !check.Ok {
+			var zero float64
+			return check, zero
+		}
+		answer := func(arg string) float64 {
+			return 3
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_two(here int) (peg.Result, float64) {
+	if result, ok := session.wherem_two[here]; ok {
+		return result, session.whatm_two[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_two[key]; ok {
+			return result, session.whatm_two[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_two(here)
+	session.depth--
+	session.wherem_two[key] = result
+	session.whatm_two[key] = value
+	session.count(here)
+	return result, value
 }
 
-func (parser Parser) m9(input []rune, here int) (Result, float64) {
-	return parser.m11(input, here)
+// root two
+func (session *Session) dm_two(here int) (peg.Result, float64) {
+	return func(here int) (peg.Result, float64) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+3) || string(session.slice(here, here+3)) != "two" {
+				return session.failures.Fail(here, peg.Expected{Token: "two"}), ""
+			}
+			return peg.Success(here + 3), "two"
+		}(here)
+		if !check.Ok {
+			var zero float64
+			return check, zero
+		}
+		answer := func(arg string) float64 {
+			return 2
+		}(value)
+		return check, answer
+	}(here)
 }
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/nathan-fenner/go-peg-tree/core"
 )
@@ -33,5 +35,14 @@ func main() {
 	})
 	state.DefineRoot("Expression", core.Root{"sum", "float64"})
 
-	fmt.Print(state.Generate("arithmetic"))
+	options := core.Options{}
+	options.Flags(flag.CommandLine)
+	flag.Parse()
+	source, pruned, err := state.GeneratePruned("arithmetic", options)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Fprint(os.Stderr, pruned)
+	fmt.Print(source)
 }
@@ -71,6 +71,29 @@ func buildUnit(b Build, suffix string) Build {
 	panic(`buildUnit must be given "*" or "?" or "+"`)
 }
 
+// BuildLabel is an atom followed by "^" and the message to report if it fails,
+// or by "^^" if the message should also say where the sequence began.
+type BuildLabel struct {
+	Argument Build
+	Message  string
+	Opening  bool
+}
+
+func (build BuildLabel) Build(roots map[string]string) (core.Peg, error) {
+	contents, err := build.Argument.Build(roots)
+	if err != nil {
+		return nil, err
+	}
+	return core.Label{contents, build.Message, build.Opening}, nil
+}
+
+// BuildCut is a "~", which commits the sequence it appears in.
+type BuildCut struct{}
+
+func (build BuildCut) Build(roots map[string]string) (core.Peg, error) {
+	return core.Cut{}, nil
+}
+
 type BuildSequence []Build
 
 type ErrorSequence []error
@@ -147,6 +170,7 @@ type BuildGo struct {
 	Argument   Build
 	Returns    string
 	Expression string
+	Form       string // "go", or "try" or "try!" if the expression also returns an error
 }
 
 func (build BuildGo) Build(roots map[string]string) (core.Peg, error) {
@@ -154,6 +178,9 @@ func (build BuildGo) Build(roots map[string]string) (core.Peg, error) {
 	if err != nil {
 		return nil, err
 	}
+	if build.Form != "go" {
+		return core.Try{contents, build.Returns, build.Expression, build.Form == "try!"}, nil
+	}
 	return core.Go{contents, build.Returns, build.Expression}, nil
 }
 
@@ -161,13 +188,57 @@ func buildGo(build Build, buildGo *BuildGo) Build {
 	if buildGo == nil {
 		return build
 	}
-	return BuildGo{build, buildGo.Returns, buildGo.Expression}
+	return BuildGo{build, buildGo.Returns, buildGo.Expression, buildGo.Form}
 }
 
 type Rule struct {
-	Name    string
-	Returns string
-	Right   Build
+	Name       string
+	Returns    string
+	Right      Build
+	Annotation *string // "@memo" or "@nomemo", if present
+}
+
+// Define adds the rule to the state, honoring its memoization annotation.
+func (rule Rule) Define(state *core.State, roots map[string]string) error {
+	peg, err := rule.Right.Build(roots)
+	if err != nil {
+		return err
+	}
+	state.DefineRoot(rule.Name, peg)
+	if rule.Annotation != nil {
+		state.SetMemo(rule.Name, *rule.Annotation == "@memo")
+	}
+	return nil
+}
+
+// DefineRules builds a state holding the rules parsed from a grammar, which
+// may refer to each other in any order.
+func DefineRules(rules []Rule) (core.State, error) {
+	state := core.NewState()
+	roots := map[string]string{}
+	for _, rule := range rules {
+		roots[rule.Name] = rule.Returns
+	}
+	errs := ErrorSequence{}
+	for _, rule := range rules {
+		if err := rule.Define(&state, roots); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return state, errs
+	}
+	return state, nil
+}
+
+// ReadGrammar builds a state holding the rules of a grammar written in the
+// syntax that this parser parses.
+func ReadGrammar(source []byte) (core.State, error) {
+	rules, err := NewParser().ParseRules(source)
+	if err != nil {
+		return core.State{}, err
+	}
+	return DefineRules(rules)
 }
 
 func unescapeString(s string) string {
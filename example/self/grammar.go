@@ -1,114 +1,3586 @@
-package self
+package main
 
-import "fmt"
+import "context"
+import "errors"
+import "io"
 import "regexp"
+import "unicode/utf8"
+import peg "github.com/nathan-fenner/go-peg-tree/core/runtime"
 
-func NewParser(input string) Parser {
-	return Parser{
-		input:           []byte(input),
-		wherem1:         map[int]Result{},
-		whatm1:          map[int]string{},
-		resourcem1Regex: regexp.MustCompile("[a-zA-Z_][a-zA-Z_0-9]*"),
-		wherem0:         map[int]Result{},
-		whatm0:          map[int]string{},
-	}
+// Parser holds what is shared by every parse of the grammar. It is never
+// modified, so one Parser can be used from several goroutines at once.
+type Parser struct{}
+
+func NewParser() Parser {
+	return Parser{}
+}
+
+// ParseRules parses the whole input as Rules, in a fresh session.
+func (parser Parser) ParseRules(input []byte) ([]Rule, error) {
+	return parser.NewSession(input).Rules()
+}
+
+// ParseRulesPrefix parses as much of the input as Rules matches, in a fresh
+// session, and returns how many bytes that was.
+func (parser Parser) ParseRulesPrefix(input []byte) ([]Rule, int, error) {
+	return parser.NewSession(input).RulesPrefix()
 }
 
-type Parser struct {
-	input []byte
+// ParseRulesContext parses the input as Rules, in a fresh session,
+// giving up with a *LimitError if the context is done or a limit is exceeded.
+func (parser Parser) ParseRulesContext(ctx context.Context, input []byte, limits peg.Limits) ([]Rule, error) {
+	session := parser.NewSession(input)
+	session.Limit(ctx, limits)
+	return session.Rules()
+}
+
+// ParseRulesReader parses the input read from the source as Rules, in a
+// fresh session, reading only as far ahead as the parse needs.
+func (parser Parser) ParseRulesReader(source io.Reader) ([]Rule, error) {
+	return parser.NewReaderSession(source).Rules()
+}
+
+// Session holds the state of a single parse: its input and the memoization
+// tables for it. A Session can be Reset to parse another input, but must not
+// be used by more than one goroutine at a time.
+type Session struct {
+	buffer
+	limiter
+	failures peg.Tracker
+	parsed   bool // Whether a parse has begun since the session was reset
 	// Internal memoization tables
-	wherem1 map[int]Result
-	whatm1  map[int]string
-	m1Regex *regexp.Regexp
-	wherem0 map[int]Result
-	whatm0  map[int]string
+	wherem_Rules                               map[int]peg.Result
+	whatm_Rules                                map[int][]Rule
+	wherem_annotation                          map[int]peg.Result
+	whatm_annotation                           map[int]string
+	wherem_identifier                          map[int]peg.Result
+	whatm_identifier                           map[int]string
+	wherem_keyword                             map[int]peg.Result
+	whatm_keyword                              map[int]struct{}
+	wherem_mandatory_2D_space                  map[int]peg.Result
+	whatm_mandatory_2D_space                   map[int]string
+	wherem_peg_2D_alternate                    map[int]peg.Result
+	whatm_peg_2D_alternate                     map[int]Build
+	wherem_peg_2D_atom                         map[int]peg.Result
+	whatm_peg_2D_atom                          map[int]Build
+	wherem_peg_2D_cut                          map[int]peg.Result
+	whatm_peg_2D_cut                           map[int]Build
+	wherem_peg_2D_expression                   map[int]peg.Result
+	whatm_peg_2D_expression                    map[int]Build
+	wherem_peg_2D_go                           map[int]peg.Result
+	whatm_peg_2D_go                            map[int]Build
+	wherem_peg_2D_label                        map[int]peg.Result
+	whatm_peg_2D_label                         map[int]Build
+	wherem_peg_2D_label_go_seq2_lit            map[int]peg.Result
+	whatm_peg_2D_label_go_seq2_lit             map[int]string
+	wherem_peg_2D_regex                        map[int]peg.Result
+	whatm_peg_2D_regex                         map[int]Build
+	wherem_peg_2D_root                         map[int]peg.Result
+	whatm_peg_2D_root                          map[int]Build
+	wherem_peg_2D_sequence                     map[int]peg.Result
+	whatm_peg_2D_sequence                      map[int]Build
+	wherem_peg_2D_unit                         map[int]peg.Result
+	whatm_peg_2D_unit                          map[int]Build
+	wherem_peg_2D_unit_2D_suffix               map[int]peg.Result
+	whatm_peg_2D_unit_2D_suffix                map[int]string
+	wherem_rule                                map[int]peg.Result
+	whatm_rule                                 map[int]Rule
+	wherem_space                               map[int]peg.Result
+	whatm_space                                map[int]string
+	wherem_string_2D_backtick                  map[int]peg.Result
+	whatm_string_2D_backtick                   map[int]string
+	wherem_string_2D_literal                   map[int]peg.Result
+	whatm_string_2D_literal                    map[int]string
+	wherem_string_2D_quote                     map[int]peg.Result
+	whatm_string_2D_quote                      map[int]string
+	wherem_type                                map[int]peg.Result
+	whatm_type                                 map[int]string
+	wherem_type_2D_head                        map[int]peg.Result
+	whatm_type_2D_head                         map[int]string
+	wherem_type_2D_head_alt0_lit               map[int]peg.Result
+	whatm_type_2D_head_alt0_lit                map[int]string
+	wherem_type_2D_head_alt2_contents_seq0_lit map[int]peg.Result
+	whatm_type_2D_head_alt2_contents_seq0_lit  map[int]string
+	wherem_type_2D_head_alt2_contents_seq2_lit map[int]peg.Result
+	whatm_type_2D_head_alt2_contents_seq2_lit  map[int]string
+	wherem_type_2D_identifier                  map[int]peg.Result
+	whatm_type_2D_identifier                   map[int]string
+}
+
+func (parser Parser) NewSession(input []byte) *Session {
+	session := &Session{}
+	session.Reset(input)
+	return session
+}
+
+// NewReaderSession makes a session which reads its input from the source as
+// the parse needs it.
+func (parser Parser) NewReaderSession(source io.Reader) *Session {
+	session := parser.NewSession(nil)
+	session.source = source
+	return session
+}
+
+// ResetReader prepares the session to parse the input read from the source.
+// The buffer holds only what the parse may still need: once every node which
+// could backtrack has moved past some input, that input is discarded.
+func (session *Session) ResetReader(source io.Reader) {
+	session.Reset(nil)
+	session.source = source
+}
+
+// Reset prepares the session to parse the given input, reusing the memory of
+// its memoization tables but forgetting their entries.
+func (session *Session) Reset(input []byte) {
+	session.input, session.origin, session.source, session.err = input, peg.Position{Line: 1, Column: 1}, nil, nil
+	session.restart()
+	session.parsed = false
+}
+
+// restart forgets what parsing the input found: its memoized results, its
+// failures and how much of the limits it used.
+func (session *Session) restart() {
+	session.lines = peg.Locator{}
+	session.depth, session.steps, session.memos = 0, 0, 0
+	session.failures.Clear()
+	session.holds = session.holds[:0]
+	if session.wherem_Rules == nil {
+		session.wherem_Rules = map[int]peg.Result{}
+		session.whatm_Rules = map[int][]Rule{}
+	}
+	for key := range session.wherem_Rules {
+		delete(session.wherem_Rules, key)
+		delete(session.whatm_Rules, key)
+	}
+	if session.wherem_annotation == nil {
+		session.wherem_annotation = map[int]peg.Result{}
+		session.whatm_annotation = map[int]string{}
+	}
+	for key := range session.wherem_annotation {
+		delete(session.wherem_annotation, key)
+		delete(session.whatm_annotation, key)
+	}
+	if session.wherem_identifier == nil {
+		session.wherem_identifier = map[int]peg.Result{}
+		session.whatm_identifier = map[int]string{}
+	}
+	for key := range session.wherem_identifier {
+		delete(session.wherem_identifier, key)
+		delete(session.whatm_identifier, key)
+	}
+	if session.wherem_keyword == nil {
+		session.wherem_keyword = map[int]peg.Result{}
+		session.whatm_keyword = map[int]struct{}{}
+	}
+	for key := range session.wherem_keyword {
+		delete(session.wherem_keyword, key)
+		delete(session.whatm_keyword, key)
+	}
+	if session.wherem_mandatory_2D_space == nil {
+		session.wherem_mandatory_2D_space = map[int]peg.Result{}
+		session.whatm_mandatory_2D_space = map[int]string{}
+	}
+	for key := range session.wherem_mandatory_2D_space {
+		delete(session.wherem_mandatory_2D_space, key)
+		delete(session.whatm_mandatory_2D_space, key)
+	}
+	if session.wherem_peg_2D_alternate == nil {
+		session.wherem_peg_2D_alternate = map[int]peg.Result{}
+		session.whatm_peg_2D_alternate = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_alternate {
+		delete(session.wherem_peg_2D_alternate, key)
+		delete(session.whatm_peg_2D_alternate, key)
+	}
+	if session.wherem_peg_2D_atom == nil {
+		session.wherem_peg_2D_atom = map[int]peg.Result{}
+		session.whatm_peg_2D_atom = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_atom {
+		delete(session.wherem_peg_2D_atom, key)
+		delete(session.whatm_peg_2D_atom, key)
+	}
+	if session.wherem_peg_2D_cut == nil {
+		session.wherem_peg_2D_cut = map[int]peg.Result{}
+		session.whatm_peg_2D_cut = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_cut {
+		delete(session.wherem_peg_2D_cut, key)
+		delete(session.whatm_peg_2D_cut, key)
+	}
+	if session.wherem_peg_2D_expression == nil {
+		session.wherem_peg_2D_expression = map[int]peg.Result{}
+		session.whatm_peg_2D_expression = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_expression {
+		delete(session.wherem_peg_2D_expression, key)
+		delete(session.whatm_peg_2D_expression, key)
+	}
+	if session.wherem_peg_2D_go == nil {
+		session.wherem_peg_2D_go = map[int]peg.Result{}
+		session.whatm_peg_2D_go = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_go {
+		delete(session.wherem_peg_2D_go, key)
+		delete(session.whatm_peg_2D_go, key)
+	}
+	if session.wherem_peg_2D_label == nil {
+		session.wherem_peg_2D_label = map[int]peg.Result{}
+		session.whatm_peg_2D_label = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_label {
+		delete(session.wherem_peg_2D_label, key)
+		delete(session.whatm_peg_2D_label, key)
+	}
+	if session.wherem_peg_2D_label_go_seq2_lit == nil {
+		session.wherem_peg_2D_label_go_seq2_lit = map[int]peg.Result{}
+		session.whatm_peg_2D_label_go_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_peg_2D_label_go_seq2_lit {
+		delete(session.wherem_peg_2D_label_go_seq2_lit, key)
+		delete(session.whatm_peg_2D_label_go_seq2_lit, key)
+	}
+	if session.wherem_peg_2D_regex == nil {
+		session.wherem_peg_2D_regex = map[int]peg.Result{}
+		session.whatm_peg_2D_regex = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_regex {
+		delete(session.wherem_peg_2D_regex, key)
+		delete(session.whatm_peg_2D_regex, key)
+	}
+	if session.wherem_peg_2D_root == nil {
+		session.wherem_peg_2D_root = map[int]peg.Result{}
+		session.whatm_peg_2D_root = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_root {
+		delete(session.wherem_peg_2D_root, key)
+		delete(session.whatm_peg_2D_root, key)
+	}
+	if session.wherem_peg_2D_sequence == nil {
+		session.wherem_peg_2D_sequence = map[int]peg.Result{}
+		session.whatm_peg_2D_sequence = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_sequence {
+		delete(session.wherem_peg_2D_sequence, key)
+		delete(session.whatm_peg_2D_sequence, key)
+	}
+	if session.wherem_peg_2D_unit == nil {
+		session.wherem_peg_2D_unit = map[int]peg.Result{}
+		session.whatm_peg_2D_unit = map[int]Build{}
+	}
+	for key := range session.wherem_peg_2D_unit {
+		delete(session.wherem_peg_2D_unit, key)
+		delete(session.whatm_peg_2D_unit, key)
+	}
+	if session.wherem_peg_2D_unit_2D_suffix == nil {
+		session.wherem_peg_2D_unit_2D_suffix = map[int]peg.Result{}
+		session.whatm_peg_2D_unit_2D_suffix = map[int]string{}
+	}
+	for key := range session.wherem_peg_2D_unit_2D_suffix {
+		delete(session.wherem_peg_2D_unit_2D_suffix, key)
+		delete(session.whatm_peg_2D_unit_2D_suffix, key)
+	}
+	if session.wherem_rule == nil {
+		session.wherem_rule = map[int]peg.Result{}
+		session.whatm_rule = map[int]Rule{}
+	}
+	for key := range session.wherem_rule {
+		delete(session.wherem_rule, key)
+		delete(session.whatm_rule, key)
+	}
+	if session.wherem_space == nil {
+		session.wherem_space = map[int]peg.Result{}
+		session.whatm_space = map[int]string{}
+	}
+	for key := range session.wherem_space {
+		delete(session.wherem_space, key)
+		delete(session.whatm_space, key)
+	}
+	if session.wherem_string_2D_backtick == nil {
+		session.wherem_string_2D_backtick = map[int]peg.Result{}
+		session.whatm_string_2D_backtick = map[int]string{}
+	}
+	for key := range session.wherem_string_2D_backtick {
+		delete(session.wherem_string_2D_backtick, key)
+		delete(session.whatm_string_2D_backtick, key)
+	}
+	if session.wherem_string_2D_literal == nil {
+		session.wherem_string_2D_literal = map[int]peg.Result{}
+		session.whatm_string_2D_literal = map[int]string{}
+	}
+	for key := range session.wherem_string_2D_literal {
+		delete(session.wherem_string_2D_literal, key)
+		delete(session.whatm_string_2D_literal, key)
+	}
+	if session.wherem_string_2D_quote == nil {
+		session.wherem_string_2D_quote = map[int]peg.Result{}
+		session.whatm_string_2D_quote = map[int]string{}
+	}
+	for key := range session.wherem_string_2D_quote {
+		delete(session.wherem_string_2D_quote, key)
+		delete(session.whatm_string_2D_quote, key)
+	}
+	if session.wherem_type == nil {
+		session.wherem_type = map[int]peg.Result{}
+		session.whatm_type = map[int]string{}
+	}
+	for key := range session.wherem_type {
+		delete(session.wherem_type, key)
+		delete(session.whatm_type, key)
+	}
+	if session.wherem_type_2D_head == nil {
+		session.wherem_type_2D_head = map[int]peg.Result{}
+		session.whatm_type_2D_head = map[int]string{}
+	}
+	for key := range session.wherem_type_2D_head {
+		delete(session.wherem_type_2D_head, key)
+		delete(session.whatm_type_2D_head, key)
+	}
+	if session.wherem_type_2D_head_alt0_lit == nil {
+		session.wherem_type_2D_head_alt0_lit = map[int]peg.Result{}
+		session.whatm_type_2D_head_alt0_lit = map[int]string{}
+	}
+	for key := range session.wherem_type_2D_head_alt0_lit {
+		delete(session.wherem_type_2D_head_alt0_lit, key)
+		delete(session.whatm_type_2D_head_alt0_lit, key)
+	}
+	if session.wherem_type_2D_head_alt2_contents_seq0_lit == nil {
+		session.wherem_type_2D_head_alt2_contents_seq0_lit = map[int]peg.Result{}
+		session.whatm_type_2D_head_alt2_contents_seq0_lit = map[int]string{}
+	}
+	for key := range session.wherem_type_2D_head_alt2_contents_seq0_lit {
+		delete(session.wherem_type_2D_head_alt2_contents_seq0_lit, key)
+		delete(session.whatm_type_2D_head_alt2_contents_seq0_lit, key)
+	}
+	if session.wherem_type_2D_head_alt2_contents_seq2_lit == nil {
+		session.wherem_type_2D_head_alt2_contents_seq2_lit = map[int]peg.Result{}
+		session.whatm_type_2D_head_alt2_contents_seq2_lit = map[int]string{}
+	}
+	for key := range session.wherem_type_2D_head_alt2_contents_seq2_lit {
+		delete(session.wherem_type_2D_head_alt2_contents_seq2_lit, key)
+		delete(session.whatm_type_2D_head_alt2_contents_seq2_lit, key)
+	}
+	if session.wherem_type_2D_identifier == nil {
+		session.wherem_type_2D_identifier = map[int]peg.Result{}
+		session.whatm_type_2D_identifier = map[int]string{}
+	}
+	for key := range session.wherem_type_2D_identifier {
+		delete(session.wherem_type_2D_identifier, key)
+		delete(session.whatm_type_2D_identifier, key)
+	}
+}
+
+// evict forgets the memoized results for positions before the given one, or
+// all of them if all is set, and counts those that remain.
+func (session *Session) evict(before int, all bool) {
+	session.memos = 0
+	for key := range session.wherem_Rules {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_Rules, key)
+			delete(session.whatm_Rules, key)
+		}
+	}
+	session.memos += len(session.wherem_Rules)
+	for key := range session.wherem_annotation {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_annotation, key)
+			delete(session.whatm_annotation, key)
+		}
+	}
+	session.memos += len(session.wherem_annotation)
+	for key := range session.wherem_identifier {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_identifier, key)
+			delete(session.whatm_identifier, key)
+		}
+	}
+	session.memos += len(session.wherem_identifier)
+	for key := range session.wherem_keyword {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_keyword, key)
+			delete(session.whatm_keyword, key)
+		}
+	}
+	session.memos += len(session.wherem_keyword)
+	for key := range session.wherem_mandatory_2D_space {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_mandatory_2D_space, key)
+			delete(session.whatm_mandatory_2D_space, key)
+		}
+	}
+	session.memos += len(session.wherem_mandatory_2D_space)
+	for key := range session.wherem_peg_2D_alternate {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_alternate, key)
+			delete(session.whatm_peg_2D_alternate, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_alternate)
+	for key := range session.wherem_peg_2D_atom {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_atom, key)
+			delete(session.whatm_peg_2D_atom, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_atom)
+	for key := range session.wherem_peg_2D_cut {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_cut, key)
+			delete(session.whatm_peg_2D_cut, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_cut)
+	for key := range session.wherem_peg_2D_expression {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_expression, key)
+			delete(session.whatm_peg_2D_expression, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_expression)
+	for key := range session.wherem_peg_2D_go {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_go, key)
+			delete(session.whatm_peg_2D_go, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_go)
+	for key := range session.wherem_peg_2D_label {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_label, key)
+			delete(session.whatm_peg_2D_label, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_label)
+	for key := range session.wherem_peg_2D_label_go_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_label_go_seq2_lit, key)
+			delete(session.whatm_peg_2D_label_go_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_label_go_seq2_lit)
+	for key := range session.wherem_peg_2D_regex {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_regex, key)
+			delete(session.whatm_peg_2D_regex, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_regex)
+	for key := range session.wherem_peg_2D_root {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_root, key)
+			delete(session.whatm_peg_2D_root, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_root)
+	for key := range session.wherem_peg_2D_sequence {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_sequence, key)
+			delete(session.whatm_peg_2D_sequence, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_sequence)
+	for key := range session.wherem_peg_2D_unit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_unit, key)
+			delete(session.whatm_peg_2D_unit, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_unit)
+	for key := range session.wherem_peg_2D_unit_2D_suffix {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_peg_2D_unit_2D_suffix, key)
+			delete(session.whatm_peg_2D_unit_2D_suffix, key)
+		}
+	}
+	session.memos += len(session.wherem_peg_2D_unit_2D_suffix)
+	for key := range session.wherem_rule {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_rule, key)
+			delete(session.whatm_rule, key)
+		}
+	}
+	session.memos += len(session.wherem_rule)
+	for key := range session.wherem_space {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_space, key)
+			delete(session.whatm_space, key)
+		}
+	}
+	session.memos += len(session.wherem_space)
+	for key := range session.wherem_string_2D_backtick {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_string_2D_backtick, key)
+			delete(session.whatm_string_2D_backtick, key)
+		}
+	}
+	session.memos += len(session.wherem_string_2D_backtick)
+	for key := range session.wherem_string_2D_literal {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_string_2D_literal, key)
+			delete(session.whatm_string_2D_literal, key)
+		}
+	}
+	session.memos += len(session.wherem_string_2D_literal)
+	for key := range session.wherem_string_2D_quote {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_string_2D_quote, key)
+			delete(session.whatm_string_2D_quote, key)
+		}
+	}
+	session.memos += len(session.wherem_string_2D_quote)
+	for key := range session.wherem_type {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type, key)
+			delete(session.whatm_type, key)
+		}
+	}
+	session.memos += len(session.wherem_type)
+	for key := range session.wherem_type_2D_head {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type_2D_head, key)
+			delete(session.whatm_type_2D_head, key)
+		}
+	}
+	session.memos += len(session.wherem_type_2D_head)
+	for key := range session.wherem_type_2D_head_alt0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type_2D_head_alt0_lit, key)
+			delete(session.whatm_type_2D_head_alt0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_type_2D_head_alt0_lit)
+	for key := range session.wherem_type_2D_head_alt2_contents_seq0_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type_2D_head_alt2_contents_seq0_lit, key)
+			delete(session.whatm_type_2D_head_alt2_contents_seq0_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_type_2D_head_alt2_contents_seq0_lit)
+	for key := range session.wherem_type_2D_head_alt2_contents_seq2_lit {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type_2D_head_alt2_contents_seq2_lit, key)
+			delete(session.whatm_type_2D_head_alt2_contents_seq2_lit, key)
+		}
+	}
+	session.memos += len(session.wherem_type_2D_head_alt2_contents_seq2_lit)
+	for key := range session.wherem_type_2D_identifier {
+		if all || key < before && -1-key < before {
+			delete(session.wherem_type_2D_identifier, key)
+			delete(session.whatm_type_2D_identifier, key)
+		}
+	}
+	session.memos += len(session.wherem_type_2D_identifier)
 }
 
+// Rules parses the whole input as Rules. If it only matches a prefix, the
+// error is at the farthest byte that any part of the grammar failed at, which
+// may be past the end of the prefix. If the grammar recovered from errors,
+// they are returned as ParseErrors, along with the value parsed around them.
+func (session *Session) Rules() (result []Rule, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, err
+	}
+	check, value := session.m_Rules(0)
+	check = session.whole(check)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, err
+	}
+	return value, err
+}
+
+// RulesPrefix parses as much of the input as Rules matches, and returns
+// how many bytes that was. Errors the grammar recovered from are returned as
+// for Rules.
+func (session *Session) RulesPrefix() (result []Rule, length int, err error) {
+	defer session.halt(&err)
+	if err := session.begin(); err != nil {
+		return result, 0, err
+	}
+	check, value := session.m_Rules(0)
+	err = session.finish(check)
+	if err != nil && len(check.Recovered) == 0 {
+		return result, 0, err
+	}
+	return value, check.At, err
+}
+
+var resourcem_identifier_go_seq1_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[\\p{L}_][\\p{L}\\d_-]*)")
+var resourcem_keyword_not_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[\\p{L}\\d_])")
+var resourcem_mandatory_2D_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:\\s+)")
+var resourcem_peg_2D_go_go_seq1_opt_go_seq6_contents_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:[^{}]+)")
+var resourcem_space_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:\\s*)")
+var resourcem_string_2D_backtick_go_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:`[^`]*`)")
+var resourcem_string_2D_quote_go_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:\"([^\\\\\"\\n]|\\\\[\"ntvb\\\\])*\")")
+var resourcem_type_2D_head_alt2_contents_seq1_regexRegex *regexp.Regexp = regexp.MustCompile("^(?:\\d+)")
+
 // Below is the internal generated parse structure.
 // It's not very efficient right now, but is accomplishes parsing in linear time.
-// Currently, there's no way to parse multiple inputs, due to the fact that the
-// state of the parse is stored in global variables.
 
-type Result struct {
-	Ok       bool
-	At       int
-	Expected []Reject
+// limiter keeps track of a session's use of its limits.
+type limiter struct {
+	ctx    context.Context
+	limits peg.Limits
+	depth  int
+	steps  int
+	memos  int
+	holds  []int // Positions that nodes in progress may backtrack to
+}
+
+// Limit makes the session give up with a *LimitError if the context is done or
+// a limit is exceeded.
+func (l *limiter) Limit(ctx context.Context, limits peg.Limits) {
+	l.ctx, l.limits = ctx, limits
+}
+
+// start is called before parsing an input of the given size.
+func (l *limiter) start(size int) error {
+	if l.limits.MaxInput > 0 && size > l.limits.MaxInput {
+		return &peg.LimitError{Limit: "input", At: l.limits.MaxInput}
+	}
+	if l.ctx != nil && l.ctx.Err() != nil {
+		return &peg.LimitError{Limit: "context", Err: l.ctx.Err()}
+	}
+	return nil
+}
+
+// begin prepares the session for a parse. If it has parsed its input already,
+// it starts over, forgetting what that parse found; unless the input was read
+// from a source and some of it has been discarded since.
+func (session *Session) begin() error {
+	if session.parsed {
+		if session.origin.Offset != 0 {
+			return errors.New("the session's input has been discarded; reset it to parse again")
+		}
+		session.restart()
+	}
+	session.parsed = true
+	return session.start(len(session.input))
+}
+
+// enter is called as each node begins. Exceeding a limit panics, to unwind the
+// parse; halt recovers it.
+func (l *limiter) enter(here int) {
+	l.depth++
+	l.steps++
+	if l.limits.MaxDepth > 0 && l.depth > l.limits.MaxDepth {
+		panic(&peg.LimitError{Limit: "depth", At: here})
+	}
+	if l.limits.MaxSteps > 0 && l.steps > l.limits.MaxSteps {
+		panic(&peg.LimitError{Limit: "steps", At: here})
+	}
+	if l.ctx != nil && l.steps%1024 == 0 && l.ctx.Err() != nil {
+		panic(&peg.LimitError{Limit: "context", At: here, Err: l.ctx.Err()})
+	}
+}
+
+// leave is called as each node ends.
+func (l *limiter) leave() {
+	l.depth--
+}
+
+// hold records that the node in progress may backtrack to here, until it is
+// released.
+func (l *limiter) hold(here int) int {
+	l.holds = append(l.holds, here)
+	return len(l.holds) - 1
+}
+
+func (l *limiter) advance(mark int, here int) {
+	l.holds[mark] = here
+}
+
+func (l *limiter) release(mark int) {
+	l.holds = l.holds[:mark]
+}
+
+// committed is the position before which the parse will never look again,
+// given that a node at here is in progress.
+func (l *limiter) committed(here int) int {
+	if len(l.holds) != 0 && l.holds[0] < here {
+		return l.holds[0]
+	}
+	return here
+}
+
+// count is called as each result is memoized.
+func (session *Session) count(here int) {
+	session.memos++
+	if session.limits.MaxMemo > 0 && session.memos > session.limits.MaxMemo {
+		panic(&peg.LimitError{Limit: "memo", At: here})
+	}
+	if session.limits.MemoBudget > 0 && session.memos > session.limits.MemoBudget {
+		session.evict(session.committed(here), false)
+		if session.memos > session.limits.MemoBudget/2 {
+			session.evict(session.committed(here), true)
+		}
+	}
+}
+
+// buffer holds as much of the input as the parse may still need. Positions are
+// always offsets from the start of the whole input, not of the buffer.
+type buffer struct {
+	input  []byte       // The input from origin onwards, as far as it has been read
+	origin peg.Position // The position of input[0]
+	source io.Reader    // Where the rest of the input comes from, if it's streamed
+	err    error        // Why the source stopped, once it has
+	lines  peg.Locator  // Finds the positions of errors
+}
+
+// end is the position just after the input read so far.
+func (b *buffer) end() int {
+	return b.origin.Offset + len(b.input)
+}
+
+// slice is the input between two positions, which must have been read.
+func (b *buffer) slice(from int, to int) []byte {
+	return b.input[from-b.origin.Offset : to-b.origin.Offset]
+}
+
+// named is where an Alias which began at here and failed at the given position
+// is named: past the whitespace it skipped, if that is all it consumed and it
+// is still buffered.
+func (b *buffer) named(here int, failed int) int {
+	if failed > here && here >= b.origin.Offset && failed <= b.end() && peg.Blank(b.slice(here, failed)) {
+		return failed
+	}
+	return here
+}
+
+// available reports whether the input extends to the given position, reading
+// more of it if necessary. here is the position of the node asking.
+func (session *Session) available(here int, to int) bool {
+	if to <= session.end() {
+		return true
+	}
+	return session.fill(here, to)
+}
+
+// fill reads from the source until the input extends to the given position or
+// the source stops. Input before the committed position is discarded first,
+// when that frees at least half of the buffer, except for the FoundLength bytes
+// just before it, which an error there quotes.
+func (session *Session) fill(here int, to int) bool {
+	if session.source == nil || session.err != nil {
+		return false
+	}
+	drop := session.committed(here) - peg.FoundLength - session.origin.Offset
+	for drop > 0 && !utf8.RuneStart(session.input[drop]) {
+		drop--
+	}
+	if drop > 0 && drop >= len(session.input)/2 {
+		session.origin = session.origin.Advance(session.input[:drop])
+		session.input = append(session.input[:0], session.input[drop:]...)
+	}
+	for session.end() < to && session.err == nil {
+		if cap(session.input)-len(session.input) < 4096 {
+			grown := make([]byte, len(session.input), 2*cap(session.input)+4096)
+			session.input = grown[:copy(grown, session.input)]
+		}
+		n, err := session.source.Read(session.input[len(session.input):cap(session.input)])
+		session.input = session.input[:len(session.input)+n]
+		session.err = err
+		if session.limits.MaxInput > 0 && session.end() > session.limits.MaxInput {
+			panic(&peg.LimitError{Limit: "input", At: session.limits.MaxInput})
+		}
+	}
+	return to <= session.end()
+}
+
+// runes reads the input rune by rune from the given position, for matching
+// regexes against streamed input.
+func (session *Session) runes(here int) io.RuneReader {
+	return &runeReader{session: session, start: here, here: here}
+}
+
+type runeReader struct {
+	session *Session
+	start   int
+	here    int
+}
+
+func (r *runeReader) ReadRune() (rune, int, error) {
+	r.session.available(r.start, r.here+utf8.UTFMax)
+	if r.here >= r.session.end() {
+		return 0, 0, io.EOF
+	}
+	to := r.here + utf8.UTFMax
+	if to > r.session.end() {
+		to = r.session.end()
+	}
+	char, size := utf8.DecodeRune(r.session.slice(r.here, to))
+	r.here += size
+	return char, size, nil
+}
+
+// whole fails a successful parse which didn't reach the end of the input,
+// keeping the errors it recovered from.
+func (session *Session) whole(check peg.Result) peg.Result {
+	if !check.Ok || !session.available(check.At, check.At+1) {
+		return check
+	}
+	failed := session.failures.Fail(check.At, peg.ExpectedEnd{})
+	failed.Recovered = check.Recovered
+	return failed
+}
+
+// finish reports how a parse with the given result ended: with the error that
+// stopped the source, if there was one, or else with the errors it recovered
+// from and a ParseError if it failed.
+func (session *Session) finish(check peg.Result) error {
+	if session.err != nil && session.err != io.EOF {
+		return session.err
+	}
+	if check.Ok {
+		return peg.Errors(check.Recovered, nil)
+	}
+	return peg.Errors(check.Recovered, session.failure(check))
+}
+
+// next is the position of the rune after the one at the given position.
+func (session *Session) next(here int) int {
+	session.available(here, here+utf8.UTFMax)
+	to := here + utf8.UTFMax
+	if to > session.end() {
+		to = session.end()
+	}
+	_, size := utf8.DecodeRune(session.slice(here, to))
+	return here + size
+}
+
+// failure describes a failed parse at the farthest position any part of it
+// reached, reading far enough ahead to say what was found there.
+func (session *Session) failure(check peg.Result) *peg.ParseError {
+	at, expected := session.failures.FailedAt, session.failures.Expected
+	if at < check.At || check.Fatal && at != check.At {
+		at, expected = check.At, check.Expected
+	}
+	session.available(at, at+peg.FoundLength)
+	return session.lines.ParseError(session.origin, session.input, at, append([]peg.Reject{}, expected...))
+}
+
+func (l *limiter) halt(err *error) {
+	if r := recover(); r != nil {
+		limit, ok := r.(*peg.LimitError)
+		if !ok {
+			panic(r)
+		}
+		*err = limit
+	}
+}
+
+func (session *Session) m_Rules(here int) (peg.Result, []Rule) {
+	if result, ok := session.wherem_Rules[here]; ok {
+		return result, session.whatm_Rules[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_Rules[key]; ok {
+			return result, session.whatm_Rules[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_Rules(here)
+	session.depth--
+	session.wherem_Rules[key] = result
+	session.whatm_Rules[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root Rules
+func (session *Session) dm_Rules(here int) (peg.Result, []Rule) {
+	return func(here int) (peg.Result, []Rule) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 []Rule
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 []Rule
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, []Rule) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := []Rule{}
+				var recovered []*peg.ParseError
+				for {
+					next, value := session.m_rule(here)
+					if !next.Ok {
+						if len(result) == 0 || next.Fatal {
+							return next, nil
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					if next.At == here && len(result) != 0 {
+						session.failures.Discard(next.Recovered)
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					here = next.At
+					session.advance(mark, here)
+					recovered = append(recovered, next.Recovered...)
+					result = append(result, value)
+				}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 []Rule
+					V1 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 []Rule
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero []Rule
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 []Rule
+			V1 string
+		}) []Rule {
+			return arg.V0
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_annotation(here int) (peg.Result, string) {
+	if result, ok := session.wherem_annotation[here]; ok {
+		return result, session.whatm_annotation[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_annotation[key]; ok {
+			return result, session.whatm_annotation[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_annotation(here)
+	session.depth--
+	session.wherem_annotation[key] = result
+	session.whatm_annotation[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root annotation
+func (session *Session) dm_annotation(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+				V2 struct{}
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+5) || string(session.slice(here, here+5)) != "@memo" {
+						return session.failures.Fail(here, peg.Expected{Token: "@memo"}), ""
+					}
+					return peg.Success(here + 5), "@memo"
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+7) || string(session.slice(here, here+7)) != "@nomemo" {
+						return session.failures.Fail(here, peg.Expected{Token: "@nomemo"}), ""
+					}
+					return peg.Success(here + 7), "@nomemo"
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+				}{}
+			}
+			if next, value := session.m_keyword(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+			V2 struct{}
+		}) string {
+			return arg.V1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_identifier(here int) (peg.Result, string) {
+	if result, ok := session.wherem_identifier[here]; ok {
+		return result, session.whatm_identifier[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_identifier[key]; ok {
+			return result, session.whatm_identifier[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_identifier(here)
+	session.depth--
+	session.wherem_identifier[key] = result
+	session.whatm_identifier[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root identifier
+func (session *Session) dm_identifier(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				var match []int
+				if session.source == nil {
+					match = resourcem_identifier_go_seq1_regexRegex.FindIndex(session.slice(here, session.end()))
+				} else {
+					match = resourcem_identifier_go_seq1_regexRegex.FindReaderIndex(session.runes(here))
+				}
+				if match == nil {
+					return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[\\p{L}_][\\p{L}\\d_-]*"}), ""
+				}
+				end := match[1]
+				return peg.Success(here + end), string(session.slice(here, here+end))
+
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+		}) string {
+			return arg.V1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_keyword(here int) (peg.Result, struct{}) {
+	if result, ok := session.wherem_keyword[here]; ok {
+		return result, session.whatm_keyword[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_keyword[key]; ok {
+			return result, session.whatm_keyword[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_keyword(here)
+	session.depth--
+	session.wherem_keyword[key] = result
+	session.whatm_keyword[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root keyword
+func (session *Session) dm_keyword(here int) (peg.Result, struct{}) {
+	return func(here int) (peg.Result, struct{}) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		session.failures.Silent++
+		check, _ := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			var match []int
+			if session.source == nil {
+				match = resourcem_keyword_not_regexRegex.FindIndex(session.slice(here, session.end()))
+			} else {
+				match = resourcem_keyword_not_regexRegex.FindReaderIndex(session.runes(here))
+			}
+			if match == nil {
+				return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[\\p{L}\\d_]"}), ""
+			}
+			end := match[1]
+			return peg.Success(here + end), string(session.slice(here, here+end))
+
+		}(here)
+		session.failures.Silent--
+		if !check.Ok {
+			return peg.Success(here), struct{}{}
+		}
+		return session.failures.Fail(here, peg.Exclude{Message: "text matching `[\\p{L}\\d_]`"}), struct{}{}
+	}(here)
+}
+
+func (session *Session) m_mandatory_2D_space(here int) (peg.Result, string) {
+	if result, ok := session.wherem_mandatory_2D_space[here]; ok {
+		return result, session.whatm_mandatory_2D_space[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_mandatory_2D_space[key]; ok {
+			return result, session.whatm_mandatory_2D_space[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_mandatory_2D_space(here)
+	session.depth--
+	session.wherem_mandatory_2D_space[key] = result
+	session.whatm_mandatory_2D_space[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root mandatory-space
+func (session *Session) dm_mandatory_2D_space(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = resourcem_mandatory_2D_space_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = resourcem_mandatory_2D_space_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "\\s+"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
+}
+
+func (session *Session) m_peg_2D_alternate(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_alternate[here]; ok {
+		return result, session.whatm_peg_2D_alternate[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_alternate[key]; ok {
+			return result, session.whatm_peg_2D_alternate[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_alternate(here)
+	session.depth--
+	session.wherem_peg_2D_alternate[key] = result
+	session.whatm_peg_2D_alternate[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-alternate
+func (session *Session) dm_peg_2D_alternate(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 Build
+			V1 []Build
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 Build
+				V1 []Build
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_peg_2D_go(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 []Build
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, []Build) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := []Build{}
+				var recovered []*peg.ParseError
+				for {
+					next, value := func(here int) (peg.Result, Build) {
+						session.enter(here)
+						defer session.leave()
+						check, value := func(here int) (peg.Result, struct {
+							V0 string
+							V1 string
+							V2 Build
+						}) {
+							session.enter(here)
+							defer session.leave()
+							result := struct {
+								V0 string
+								V1 string
+								V2 Build
+							}{}
+							var recovered []*peg.ParseError
+							if next, value := session.m_space(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V0 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 Build
+								}{}
+							}
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								if !session.available(here, here+1) || string(session.slice(here, here+1)) != "|" {
+									return session.failures.Fail(here, peg.Expected{Token: "|"}), ""
+								}
+								return peg.Success(here + 1), "|"
+							}(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V1 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 Build
+								}{}
+							}
+							if next, value := session.m_peg_2D_go(here); next.Ok {
+								here = next.At
+								recovered = append(recovered, next.Recovered...)
+								result.V2 = value
+							} else {
+								return next, struct {
+									V0 string
+									V1 string
+									V2 Build
+								}{}
+							}
+							return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+						}(here)
+						if !check.Ok {
+							var zero Build
+							return check, zero
+						}
+						answer := func(arg struct {
+							V0 string
+							V1 string
+							V2 Build
+						}) Build {
+							return arg.V2
+						}(value)
+						return check, answer
+					}(here)
+					if next.Fatal {
+						return next, nil
+					}
+					if !next.Ok {
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					if next.At == here {
+						session.failures.Discard(next.Recovered)
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					here = next.At
+					session.advance(mark, here)
+					recovered = append(recovered, next.Recovered...)
+					result = append(result, value)
+				}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 []Build
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 Build
+			V1 []Build
+		}) Build {
+			return BuildAlternate(append([]Build{arg.V0}, arg.V1...))
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_atom(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_atom[here]; ok {
+		return result, session.whatm_peg_2D_atom[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_atom[key]; ok {
+			return result, session.whatm_peg_2D_atom[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_atom(here)
+	session.depth--
+	session.wherem_peg_2D_atom[key] = result
+	session.whatm_peg_2D_atom[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-atom
+func (session *Session) dm_peg_2D_atom(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := session.m_peg_2D_regex(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_peg_2D_root(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, Build) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 Build
+				V3 string
+				V4 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 Build
+					V3 string
+					V4 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 Build
+						V3 string
+						V4 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "(" {
+						return session.failures.Fail(here, peg.Expected{Token: "("}), ""
+					}
+					return peg.Success(here + 1), "("
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 Build
+						V3 string
+						V4 string
+					}{}
+				}
+				if next, value := session.m_peg_2D_expression(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 Build
+						V3 string
+						V4 string
+					}{}
+				}
+				if next, value := session.m_space(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 Build
+						V3 string
+						V4 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != ")" {
+						return session.failures.Fail(here, peg.Expected{Token: ")"}), ""
+					}
+					return peg.Success(here + 1), ")"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V4 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 Build
+						V3 string
+						V4 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero Build
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 string
+				V2 Build
+				V3 string
+				V4 string
+			}) Build {
+				return arg.V2
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero Build
+		return failed, zero
+	}(here)
+}
+
+func (session *Session) m_peg_2D_cut(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_cut[here]; ok {
+		return result, session.whatm_peg_2D_cut[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_cut[key]; ok {
+			return result, session.whatm_peg_2D_cut[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_cut(here)
+	session.depth--
+	session.wherem_peg_2D_cut[key] = result
+	session.whatm_peg_2D_cut[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-cut
+func (session *Session) dm_peg_2D_cut(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				if !session.available(here, here+1) || string(session.slice(here, here+1)) != "~" {
+					return session.failures.Fail(here, peg.Expected{Token: "~"}), ""
+				}
+				return peg.Success(here + 1), "~"
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+		}) Build {
+			return BuildCut{}
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_expression(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_expression[here]; ok {
+		return result, session.whatm_peg_2D_expression[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_expression[key]; ok {
+			return result, session.whatm_peg_2D_expression[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_expression(here)
+	session.depth--
+	session.wherem_peg_2D_expression[key] = result
+	session.whatm_peg_2D_expression[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-expression
+func (session *Session) dm_peg_2D_expression(here int) (peg.Result, Build) {
+	return session.m_peg_2D_alternate(here)
+}
+
+func (session *Session) m_peg_2D_go(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_go[here]; ok {
+		return result, session.whatm_peg_2D_go[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_go[key]; ok {
+			return result, session.whatm_peg_2D_go[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_go(here)
+	session.depth--
+	session.wherem_peg_2D_go[key] = result
+	session.whatm_peg_2D_go[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-go
+func (session *Session) dm_peg_2D_go(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 Build
+			V1 *BuildGo
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 Build
+				V1 *BuildGo
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_peg_2D_sequence(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 *BuildGo
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, *BuildGo) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				check, value := func(here int) (peg.Result, BuildGo) {
+					session.enter(here)
+					defer session.leave()
+					check, value := func(here int) (peg.Result, struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 string
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}) {
+						session.enter(here)
+						defer session.leave()
+						result := struct {
+							V0 string
+							V1 string
+							V2 struct{}
+							V3 string
+							V4 string
+							V5 string
+							V6 string
+							V7 string
+						}{}
+						var recovered []*peg.ParseError
+						if next, value := session.m_space(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V0 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							failed := peg.Result{At: here}
+
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								if !session.available(here, here+2) || string(session.slice(here, here+2)) != "go" {
+									return session.failures.Fail(here, peg.Expected{Token: "go"}), ""
+								}
+								return peg.Success(here + 2), "go"
+							}(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								if !session.available(here, here+4) || string(session.slice(here, here+4)) != "try!" {
+									return session.failures.Fail(here, peg.Expected{Token: "try!"}), ""
+								}
+								return peg.Success(here + 4), "try!"
+							}(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							if next, value := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								if !session.available(here, here+3) || string(session.slice(here, here+3)) != "try" {
+									return session.failures.Fail(here, peg.Expected{Token: "try"}), ""
+								}
+								return peg.Success(here + 3), "try"
+							}(here); next.Ok || next.Fatal {
+								return next, value
+							} else {
+								failed = peg.Farthest(failed, next)
+							}
+							var zero string
+							return failed, zero
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V1 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := session.m_keyword(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V2 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := session.m_type(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V3 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := session.m_space(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V4 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							if !session.available(here, here+1) || string(session.slice(here, here+1)) != "{" {
+								return session.failures.Fail(here, peg.Expected{Token: "{"}), ""
+							}
+							return peg.Success(here + 1), "{"
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V5 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							mark := session.hold(here)
+							defer session.release(mark)
+							check, _ := func(here int) (peg.Result, string) {
+								session.enter(here)
+								defer session.leave()
+								var match []int
+								if session.source == nil {
+									match = resourcem_peg_2D_go_go_seq1_opt_go_seq6_contents_regexRegex.FindIndex(session.slice(here, session.end()))
+								} else {
+									match = resourcem_peg_2D_go_go_seq1_opt_go_seq6_contents_regexRegex.FindReaderIndex(session.runes(here))
+								}
+								if match == nil {
+									return session.failures.Fail(here, peg.ExpectedPattern{Regex: "[^{}]+"}), ""
+								}
+								end := match[1]
+								return peg.Success(here + end), string(session.slice(here, here+end))
+
+							}(here)
+							if check.Ok {
+								return check, string(session.slice(here, check.At))
+							}
+							return check, ""
+
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V6 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						if next, value := func(here int) (peg.Result, string) {
+							session.enter(here)
+							defer session.leave()
+							if !session.available(here, here+1) || string(session.slice(here, here+1)) != "}" {
+								return session.failures.Fail(here, peg.Expected{Token: "}"}), ""
+							}
+							return peg.Success(here + 1), "}"
+						}(here); next.Ok {
+							here = next.At
+							recovered = append(recovered, next.Recovered...)
+							result.V7 = value
+						} else {
+							return next, struct {
+								V0 string
+								V1 string
+								V2 struct{}
+								V3 string
+								V4 string
+								V5 string
+								V6 string
+								V7 string
+							}{}
+						}
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}(here)
+					if !check.Ok {
+						var zero BuildGo
+						return check, zero
+					}
+					answer := func(arg struct {
+						V0 string
+						V1 string
+						V2 struct{}
+						V3 string
+						V4 string
+						V5 string
+						V6 string
+						V7 string
+					}) BuildGo {
+						return BuildGo{nil, arg.V3, arg.V6, arg.V1}
+					}(value)
+					return check, answer
+				}(here)
+				if check.Ok {
+					return check, &value
+				}
+				if check.Fatal {
+					return check, nil
+				}
+				return peg.Success(here), nil
+
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 *BuildGo
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 Build
+			V1 *BuildGo
+		}) Build {
+			return buildGo(arg.V0, arg.V1)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_label(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_label[here]; ok {
+		return result, session.whatm_peg_2D_label[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_label[key]; ok {
+			return result, session.whatm_peg_2D_label[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_label(here)
+	session.depth--
+	session.wherem_peg_2D_label[key] = result
+	session.whatm_peg_2D_label[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-label
+func (session *Session) dm_peg_2D_label(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 Build
+			V1 string
+			V2 string
+			V3 *string
+			V4 string
+			V5 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 Build
+				V1 string
+				V2 string
+				V3 *string
+				V4 string
+				V5 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_peg_2D_atom(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			if next, value := session.m_peg_2D_label_go_seq2_lit(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, *string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				check, value := session.m_peg_2D_label_go_seq2_lit(here)
+				if check.Ok {
+					return check, &value
+				}
+				if check.Fatal {
+					return check, nil
+				}
+				return peg.Success(here), nil
+
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V3 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V4 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			if next, value := session.m_string_2D_literal(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V5 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+					V2 string
+					V3 *string
+					V4 string
+					V5 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 Build
+			V1 string
+			V2 string
+			V3 *string
+			V4 string
+			V5 string
+		}) Build {
+			return BuildLabel{arg.V0, arg.V5, arg.V3 != nil}
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_label_go_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_peg_2D_label_go_seq2_lit[here]; ok {
+		return result, session.whatm_peg_2D_label_go_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_label_go_seq2_lit[key]; ok {
+			return result, session.whatm_peg_2D_label_go_seq2_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_label_go_seq2_lit(here)
+	session.depth--
+	session.wherem_peg_2D_label_go_seq2_lit[key] = result
+	session.whatm_peg_2D_label_go_seq2_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "^"
+func (session *Session) dm_peg_2D_label_go_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "^" {
+		return session.failures.Fail(here, peg.Expected{Token: "^"}), ""
+	}
+	return peg.Success(here + 1), "^"
+}
+
+func (session *Session) m_peg_2D_regex(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_regex[here]; ok {
+		return result, session.whatm_peg_2D_regex[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_regex[key]; ok {
+			return result, session.whatm_peg_2D_regex[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_regex(here)
+	session.depth--
+	session.wherem_peg_2D_regex[key] = result
+	session.whatm_peg_2D_regex[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-regex
+func (session *Session) dm_peg_2D_regex(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 string
+			V4 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+				V2 struct{}
+				V3 string
+				V4 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				if !session.available(here, here+5) || string(session.slice(here, here+5)) != "regex" {
+					return session.failures.Fail(here, peg.Expected{Token: "regex"}), ""
+				}
+				return peg.Success(here + 5), "regex"
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_keyword(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V3 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 string
+					V4 string
+				}{}
+			}
+			if next, value := session.m_string_2D_literal(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V4 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+					V2 struct{}
+					V3 string
+					V4 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+			V2 struct{}
+			V3 string
+			V4 string
+		}) Build {
+			return BuildRegex(arg.V4)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_root(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_root[here]; ok {
+		return result, session.whatm_peg_2D_root[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_root[key]; ok {
+			return result, session.whatm_peg_2D_root[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_root(here)
+	session.depth--
+	session.wherem_peg_2D_root[key] = result
+	session.whatm_peg_2D_root[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-root
+func (session *Session) dm_peg_2D_root(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := session.m_identifier(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg string) Build {
+			return BuildRoot{arg}
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_sequence(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_sequence[here]; ok {
+		return result, session.whatm_peg_2D_sequence[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_sequence[key]; ok {
+			return result, session.whatm_peg_2D_sequence[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_sequence(here)
+	session.depth--
+	session.wherem_peg_2D_sequence[key] = result
+	session.whatm_peg_2D_sequence[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-sequence
+func (session *Session) dm_peg_2D_sequence(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, []Build) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			result := []Build{}
+			var recovered []*peg.ParseError
+			for {
+				next, value := func(here int) (peg.Result, Build) {
+					session.enter(here)
+					defer session.leave()
+					mark := session.hold(here)
+					defer session.release(mark)
+					failed := peg.Result{At: here}
+
+					if next, value := session.m_peg_2D_cut(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_peg_2D_label(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					if next, value := session.m_peg_2D_unit(here); next.Ok || next.Fatal {
+						return next, value
+					} else {
+						failed = peg.Farthest(failed, next)
+					}
+					var zero Build
+					return failed, zero
+				}(here)
+				if !next.Ok {
+					if len(result) == 0 || next.Fatal {
+						return next, nil
+					}
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}
+				if next.At == here && len(result) != 0 {
+					session.failures.Discard(next.Recovered)
+					return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+				}
+				here = next.At
+				session.advance(mark, here)
+				recovered = append(recovered, next.Recovered...)
+				result = append(result, value)
+			}
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg []Build) Build {
+			return BuildSequence(arg)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_unit(here int) (peg.Result, Build) {
+	if result, ok := session.wherem_peg_2D_unit[here]; ok {
+		return result, session.whatm_peg_2D_unit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_unit[key]; ok {
+			return result, session.whatm_peg_2D_unit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_unit(here)
+	session.depth--
+	session.wherem_peg_2D_unit[key] = result
+	session.whatm_peg_2D_unit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-unit
+func (session *Session) dm_peg_2D_unit(here int) (peg.Result, Build) {
+	return func(here int) (peg.Result, Build) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 Build
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 Build
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_peg_2D_atom(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+				}{}
+			}
+			if next, value := session.m_peg_2D_unit_2D_suffix(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 Build
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Build
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 Build
+			V1 string
+		}) Build {
+			return buildUnit(arg.V0, arg.V1)
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_peg_2D_unit_2D_suffix(here int) (peg.Result, string) {
+	if result, ok := session.wherem_peg_2D_unit_2D_suffix[here]; ok {
+		return result, session.whatm_peg_2D_unit_2D_suffix[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_peg_2D_unit_2D_suffix[key]; ok {
+			return result, session.whatm_peg_2D_unit_2D_suffix[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_peg_2D_unit_2D_suffix(here)
+	session.depth--
+	session.wherem_peg_2D_unit_2D_suffix[key] = result
+	session.whatm_peg_2D_unit_2D_suffix[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root peg-unit-suffix
+func (session *Session) dm_peg_2D_unit_2D_suffix(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				failed := peg.Result{At: here}
+
+				if next, value := session.m_type_2D_head_alt0_lit(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "+" {
+						return session.failures.Fail(here, peg.Expected{Token: "+"}), ""
+					}
+					return peg.Success(here + 1), "+"
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+1) || string(session.slice(here, here+1)) != "?" {
+						return session.failures.Fail(here, peg.Expected{Token: "?"}), ""
+					}
+					return peg.Success(here + 1), "?"
+				}(here); next.Ok || next.Fatal {
+					return next, value
+				} else {
+					failed = peg.Farthest(failed, next)
+				}
+				var zero string
+				return failed, zero
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 string
+			V1 string
+		}) string {
+			return arg.V1
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_rule(here int) (peg.Result, Rule) {
+	if result, ok := session.wherem_rule[here]; ok {
+		return result, session.whatm_rule[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_rule[key]; ok {
+			return result, session.whatm_rule[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_rule(here)
+	session.depth--
+	session.wherem_rule[key] = result
+	session.whatm_rule[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root rule
+func (session *Session) dm_rule(here int) (peg.Result, Rule) {
+	return func(here int) (peg.Result, Rule) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, struct {
+			V0 *string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+			V5 Build
+			V6 string
+			V7 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 *string
+				V1 string
+				V2 string
+				V3 string
+				V4 string
+				V5 Build
+				V6 string
+				V7 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, *string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				check, value := session.m_annotation(here)
+				if check.Ok {
+					return check, &value
+				}
+				if check.Fatal {
+					return check, nil
+				}
+				return peg.Success(here), nil
+
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := session.m_identifier(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := session.m_type(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V2 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V3 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				if !session.available(here, here+2) || string(session.slice(here, here+2)) != "<-" {
+					return session.failures.Fail(here, peg.Expected{Token: "<-"}), ""
+				}
+				return peg.Success(here + 2), "<-"
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V4 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := session.m_peg_2D_expression(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V5 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := session.m_space(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V6 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			if next, value := func(here int) (peg.Result, string) {
+				session.enter(here)
+				defer session.leave()
+				if !session.available(here, here+1) || string(session.slice(here, here+1)) != ";" {
+					return session.failures.Fail(here, peg.Expected{Token: ";"}), ""
+				}
+				return peg.Success(here + 1), ";"
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V7 = value
+			} else {
+				return next, struct {
+					V0 *string
+					V1 string
+					V2 string
+					V3 string
+					V4 string
+					V5 Build
+					V6 string
+					V7 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if !check.Ok {
+			var zero Rule
+			return check, zero
+		}
+		answer := func(arg struct {
+			V0 *string
+			V1 string
+			V2 string
+			V3 string
+			V4 string
+			V5 Build
+			V6 string
+			V7 string
+		}) Rule {
+			return Rule{arg.V1, arg.V2, arg.V5, arg.V0}
+		}(value)
+		return check, answer
+	}(here)
+}
+
+func (session *Session) m_space(here int) (peg.Result, string) {
+	if result, ok := session.wherem_space[here]; ok {
+		return result, session.whatm_space[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_space[key]; ok {
+			return result, session.whatm_space[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_space(here)
+	session.depth--
+	session.wherem_space[key] = result
+	session.whatm_space[key] = value
+	session.count(here)
+	return result, value
 }
 
-type Reject interface {
-	Reason() string
+// root space
+func (session *Session) dm_space(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		var match []int
+		if session.source == nil {
+			match = resourcem_space_regexRegex.FindIndex(session.slice(here, session.end()))
+		} else {
+			match = resourcem_space_regexRegex.FindReaderIndex(session.runes(here))
+		}
+		if match == nil {
+			return session.failures.Fail(here, peg.ExpectedPattern{Regex: "\\s*"}), ""
+		}
+		end := match[1]
+		return peg.Success(here + end), string(session.slice(here, here+end))
+
+	}(here)
 }
 
-func (r Result) Explain() string {
-	if r.Ok {
-		return fmt.Sprintf("Okay: %d characters parsed", r.At)
+func (session *Session) m_string_2D_backtick(here int) (peg.Result, string) {
+	if result, ok := session.wherem_string_2D_backtick[here]; ok {
+		return result, session.whatm_string_2D_backtick[here]
 	}
-	s := "Failed to parse. Expected at " + fmt.Sprintf("%d", r.At) + " one of:"
-	for _, v := range r.Expected {
-		s += "\n\t" + v.Reason()
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_string_2D_backtick[key]; ok {
+			return result, session.whatm_string_2D_backtick[key]
+		}
 	}
-	return s
+	session.enter(here)
+	result, value := session.dm_string_2D_backtick(here)
+	session.depth--
+	session.wherem_string_2D_backtick[key] = result
+	session.whatm_string_2D_backtick[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root string-backtick
+func (session *Session) dm_string_2D_backtick(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			var match []int
+			if session.source == nil {
+				match = resourcem_string_2D_backtick_go_regexRegex.FindIndex(session.slice(here, session.end()))
+			} else {
+				match = resourcem_string_2D_backtick_go_regexRegex.FindReaderIndex(session.runes(here))
+			}
+			if match == nil {
+				return session.failures.Fail(here, peg.ExpectedPattern{Regex: "`[^`]*`"}), ""
+			}
+			end := match[1]
+			return peg.Success(here + end), string(session.slice(here, here+end))
+
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg string) string {
+			return arg[1 : len(arg)-1]
+		}(value)
+		return check, answer
+	}(here)
 }
 
-type Expected struct {
-	Token string
+func (session *Session) m_string_2D_literal(here int) (peg.Result, string) {
+	if result, ok := session.wherem_string_2D_literal[here]; ok {
+		return result, session.whatm_string_2D_literal[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_string_2D_literal[key]; ok {
+			return result, session.whatm_string_2D_literal[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_string_2D_literal(here)
+	session.depth--
+	session.wherem_string_2D_literal[key] = result
+	session.whatm_string_2D_literal[key] = value
+	session.count(here)
+	return result, value
 }
 
-func (e Expected) Reason() string {
-	return fmt.Sprintf("%q", e.Token)
+// root string-literal
+func (session *Session) dm_string_2D_literal(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := session.m_string_2D_backtick(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := session.m_string_2D_quote(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
 }
 
-func Failure(tokens ...Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: tokens,
+func (session *Session) m_string_2D_quote(here int) (peg.Result, string) {
+	if result, ok := session.wherem_string_2D_quote[here]; ok {
+		return result, session.whatm_string_2D_quote[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_string_2D_quote[key]; ok {
+			return result, session.whatm_string_2D_quote[key]
+		}
 	}
+	session.enter(here)
+	result, value := session.dm_string_2D_quote(here)
+	session.depth--
+	session.wherem_string_2D_quote[key] = result
+	session.whatm_string_2D_quote[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root string-quote
+func (session *Session) dm_string_2D_quote(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		check, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			var match []int
+			if session.source == nil {
+				match = resourcem_string_2D_quote_go_regexRegex.FindIndex(session.slice(here, session.end()))
+			} else {
+				match = resourcem_string_2D_quote_go_regexRegex.FindReaderIndex(session.runes(here))
+			}
+			if match == nil {
+				return session.failures.Fail(here, peg.ExpectedPattern{Regex: "\"([^\\\\\"\\n]|\\\\[\"ntvb\\\\])*\""}), ""
+			}
+			end := match[1]
+			return peg.Success(here + end), string(session.slice(here, here+end))
+
+		}(here)
+		if !check.Ok {
+			var zero string
+			return check, zero
+		}
+		answer := func(arg string) string {
+			return unescapeString(arg[1 : len(arg)-1])
+		}(value)
+		return check, answer
+	}(here)
 }
-func FailureCombined(first []Reject, second []Reject) Result {
-	return Result{
-		Ok:       false,
-		Expected: append(append([]Reject{}, first...), second...),
+
+func (session *Session) m_type(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type[here]; ok {
+		return result, session.whatm_type[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type[key]; ok {
+			return result, session.whatm_type[key]
+		}
 	}
+	session.enter(here)
+	result, value := session.dm_type(here)
+	session.depth--
+	session.wherem_type[key] = result
+	session.whatm_type[key] = value
+	session.count(here)
+	return result, value
+}
+
+// root type
+func (session *Session) dm_type(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		check, _ := func(here int) (peg.Result, struct {
+			V0 []string
+			V1 string
+		}) {
+			session.enter(here)
+			defer session.leave()
+			result := struct {
+				V0 []string
+				V1 string
+			}{}
+			var recovered []*peg.ParseError
+			if next, value := func(here int) (peg.Result, []string) {
+				session.enter(here)
+				defer session.leave()
+				mark := session.hold(here)
+				defer session.release(mark)
+				result := []string{}
+				var recovered []*peg.ParseError
+				for {
+					next, value := session.m_type_2D_head(here)
+					if next.Fatal {
+						return next, nil
+					}
+					if !next.Ok {
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					if next.At == here {
+						session.failures.Discard(next.Recovered)
+						return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+					}
+					here = next.At
+					session.advance(mark, here)
+					recovered = append(recovered, next.Recovered...)
+					result = append(result, value)
+				}
+			}(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V0 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			if next, value := session.m_identifier(here); next.Ok {
+				here = next.At
+				recovered = append(recovered, next.Recovered...)
+				result.V1 = value
+			} else {
+				return next, struct {
+					V0 []string
+					V1 string
+				}{}
+			}
+			return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+		}(here)
+		if check.Ok {
+			return check, string(session.slice(here, check.At))
+		}
+		return check, ""
+
+	}(here)
 }
-func Success(at int) Result {
-	return Result{
-		Ok: true,
-		At: at,
+
+func (session *Session) m_type_2D_head(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type_2D_head[here]; ok {
+		return result, session.whatm_type_2D_head[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type_2D_head[key]; ok {
+			return result, session.whatm_type_2D_head[key]
+		}
 	}
+	session.enter(here)
+	result, value := session.dm_type_2D_head(here)
+	session.depth--
+	session.wherem_type_2D_head[key] = result
+	session.whatm_type_2D_head[key] = value
+	session.count(here)
+	return result, value
 }
 
-type Exclude struct {
-	Message string
+// root type-head
+func (session *Session) dm_type_2D_head(here int) (peg.Result, string) {
+	return func(here int) (peg.Result, string) {
+		session.enter(here)
+		defer session.leave()
+		mark := session.hold(here)
+		defer session.release(mark)
+		failed := peg.Result{At: here}
+
+		if next, value := session.m_type_2D_head_alt0_lit(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+2) || string(session.slice(here, here+2)) != "[]" {
+				return session.failures.Fail(here, peg.Expected{Token: "[]"}), ""
+			}
+			return peg.Success(here + 2), "[]"
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := session.m_type_2D_head_alt2_contents_seq0_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					var match []int
+					if session.source == nil {
+						match = resourcem_type_2D_head_alt2_contents_seq1_regexRegex.FindIndex(session.slice(here, session.end()))
+					} else {
+						match = resourcem_type_2D_head_alt2_contents_seq1_regexRegex.FindReaderIndex(session.runes(here))
+					}
+					if match == nil {
+						return session.failures.Fail(here, peg.ExpectedPattern{Regex: "\\d+"}), ""
+					}
+					end := match[1]
+					return peg.Success(here + end), string(session.slice(here, here+end))
+
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				if next, value := session.m_type_2D_head_alt2_contents_seq2_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			mark := session.hold(here)
+			defer session.release(mark)
+			check, _ := func(here int) (peg.Result, struct {
+				V0 string
+				V1 string
+				V2 string
+				V3 string
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 string
+					V2 string
+					V3 string
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+3) || string(session.slice(here, here+3)) != "map" {
+						return session.failures.Fail(here, peg.Expected{Token: "map"}), ""
+					}
+					return peg.Success(here + 3), "map"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+						V3 string
+					}{}
+				}
+				if next, value := session.m_type_2D_head_alt2_contents_seq0_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+						V3 string
+					}{}
+				}
+				if next, value := session.m_type(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V2 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+						V3 string
+					}{}
+				}
+				if next, value := session.m_type_2D_head_alt2_contents_seq2_lit(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V3 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 string
+						V2 string
+						V3 string
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if check.Ok {
+				return check, string(session.slice(here, check.At))
+			}
+			return check, ""
+
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			check, value := func(here int) (peg.Result, struct {
+				V0 string
+				V1 struct{}
+			}) {
+				session.enter(here)
+				defer session.leave()
+				result := struct {
+					V0 string
+					V1 struct{}
+				}{}
+				var recovered []*peg.ParseError
+				if next, value := func(here int) (peg.Result, string) {
+					session.enter(here)
+					defer session.leave()
+					if !session.available(here, here+4) || string(session.slice(here, here+4)) != "chan" {
+						return session.failures.Fail(here, peg.Expected{Token: "chan"}), ""
+					}
+					return peg.Success(here + 4), "chan"
+				}(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V0 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+					}{}
+				}
+				if next, value := session.m_keyword(here); next.Ok {
+					here = next.At
+					recovered = append(recovered, next.Recovered...)
+					result.V1 = value
+				} else {
+					return next, struct {
+						V0 string
+						V1 struct{}
+					}{}
+				}
+				return peg.Result{Ok: true, At: here, Recovered: recovered}, result
+			}(here)
+			if !check.Ok {
+				var zero string
+				return check, zero
+			}
+			answer := func(arg struct {
+				V0 string
+				V1 struct{}
+			}) string {
+				return "chan "
+			}(value)
+			return check, answer
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+7) || string(session.slice(here, here+7)) != "<-chan " {
+				return session.failures.Fail(here, peg.Expected{Token: "<-chan "}), ""
+			}
+			return peg.Success(here + 7), "<-chan "
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		if next, value := func(here int) (peg.Result, string) {
+			session.enter(here)
+			defer session.leave()
+			if !session.available(here, here+7) || string(session.slice(here, here+7)) != "chan<- " {
+				return session.failures.Fail(here, peg.Expected{Token: "chan<- "}), ""
+			}
+			return peg.Success(here + 7), "chan<- "
+		}(here); next.Ok || next.Fatal {
+			return next, value
+		} else {
+			failed = peg.Farthest(failed, next)
+		}
+		var zero string
+		return failed, zero
+	}(here)
 }
 
-func (e Exclude) Reason() string {
-	return fmt.Sprintf("but not %s", e.Message)
+func (session *Session) m_type_2D_head_alt0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type_2D_head_alt0_lit[here]; ok {
+		return result, session.whatm_type_2D_head_alt0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type_2D_head_alt0_lit[key]; ok {
+			return result, session.whatm_type_2D_head_alt0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_type_2D_head_alt0_lit(here)
+	session.depth--
+	session.wherem_type_2D_head_alt0_lit[key] = result
+	session.whatm_type_2D_head_alt0_lit[key] = value
+	session.count(here)
+	return result, value
 }
 
-func (parser Parser) m0(input []byte, here int) (Result, string) {
-	return parser.m1(input, here)
+// "*"
+func (session *Session) dm_type_2D_head_alt0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "*" {
+		return session.failures.Fail(here, peg.Expected{Token: "*"}), ""
+	}
+	return peg.Success(here + 1), "*"
 }
 
-var wherem1 = map[int]Result{}
-var whatm1 = map[int]string{}
+func (session *Session) m_type_2D_head_alt2_contents_seq0_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type_2D_head_alt2_contents_seq0_lit[here]; ok {
+		return result, session.whatm_type_2D_head_alt2_contents_seq0_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type_2D_head_alt2_contents_seq0_lit[key]; ok {
+			return result, session.whatm_type_2D_head_alt2_contents_seq0_lit[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_type_2D_head_alt2_contents_seq0_lit(here)
+	session.depth--
+	session.wherem_type_2D_head_alt2_contents_seq0_lit[key] = result
+	session.whatm_type_2D_head_alt2_contents_seq0_lit[key] = value
+	session.count(here)
+	return result, value
+}
+
+// "["
+func (session *Session) dm_type_2D_head_alt2_contents_seq0_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "[" {
+		return session.failures.Fail(here, peg.Expected{Token: "["}), ""
+	}
+	return peg.Success(here + 1), "["
+}
 
-func (parser Parser) m1(input []byte, here int) (Result, string) {
-	if result, ok := parser.wherem1[here]; ok {
-		return result, parser.whatm1[here]
+func (session *Session) m_type_2D_head_alt2_contents_seq2_lit(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type_2D_head_alt2_contents_seq2_lit[here]; ok {
+		return result, session.whatm_type_2D_head_alt2_contents_seq2_lit[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type_2D_head_alt2_contents_seq2_lit[key]; ok {
+			return result, session.whatm_type_2D_head_alt2_contents_seq2_lit[key]
+		}
 	}
-	result, value := parser.dm1(input, here)
-	parser.wherem1[here] = result
-	parser.whatm1[here] = value
+	session.enter(here)
+	result, value := session.dm_type_2D_head_alt2_contents_seq2_lit(here)
+	session.depth--
+	session.wherem_type_2D_head_alt2_contents_seq2_lit[key] = result
+	session.whatm_type_2D_head_alt2_contents_seq2_lit[key] = value
+	session.count(here)
 	return result, value
 }
 
-// regex "[a-zA-Z_][a-zA-Z_0-9]*"
-func (parser Parser) dm1(input []byte, here int) (Result, string) {
-	match := parser.resourcem1Regex.Index(input[here:])
-	if match == nil || match[0] != 0 {
-		return Failure(Expected{Token: "regex " + "[a-zA-Z_][a-zA-Z_0-9]*"})
+// "]"
+func (session *Session) dm_type_2D_head_alt2_contents_seq2_lit(here int) (peg.Result, string) {
+	if !session.available(here, here+1) || string(session.slice(here, here+1)) != "]" {
+		return session.failures.Fail(here, peg.Expected{Token: "]"}), ""
 	}
-	end := match[1]
-	return Success(here + end), string(input[here : here+end])
+	return peg.Success(here + 1), "]"
+}
+
+func (session *Session) m_type_2D_identifier(here int) (peg.Result, string) {
+	if result, ok := session.wherem_type_2D_identifier[here]; ok {
+		return result, session.whatm_type_2D_identifier[here]
+	}
+	key := here
+	if session.failures.Silent != 0 {
+		key = -1 - here
+		if result, ok := session.wherem_type_2D_identifier[key]; ok {
+			return result, session.whatm_type_2D_identifier[key]
+		}
+	}
+	session.enter(here)
+	result, value := session.dm_type_2D_identifier(here)
+	session.depth--
+	session.wherem_type_2D_identifier[key] = result
+	session.whatm_type_2D_identifier[key] = value
+	session.count(here)
+	return result, value
+}
 
+// root type-identifier
+func (session *Session) dm_type_2D_identifier(here int) (peg.Result, string) {
+	return session.m_identifier(here)
 }
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
 
 	"github.com/nathan-fenner/go-peg-tree/core"
 )
@@ -60,19 +62,19 @@ func main() {
 		core.Sequence{
 			core.Root{"space", "string"},
 			core.Literal("regex"),
-			core.Root{"keyword", "string"},
+			core.Root{"keyword", "struct{}"},
 			core.Root{"space", "string"},
 			core.Root{"string-literal", "string"},
 		},
 		"Build",
-		"BuildRegex{arg[4]}",
+		"BuildRegex(arg.V4)",
 	})
 
 	state.DefineRoot(
 		"peg-atom",
 		core.Alternate{
-			core.Root{"peg-root", "Build"},
 			core.Root{"peg-regex", "Build"},
+			core.Root{"peg-root", "Build"},
 			core.Go{
 				core.Sequence{core.Root{"space", "string"}, core.Literal("("), core.Root{"peg-expression", "Build"}, core.Root{"space", "string"}, core.Literal(")")},
 				"Build",
@@ -109,10 +111,29 @@ func main() {
 		},
 	)
 
+	state.DefineRoot("peg-cut", core.Go{
+		core.Sequence{core.Root{"space", "string"}, core.Literal("~")},
+		"Build",
+		"BuildCut{}",
+	})
+
+	state.DefineRoot("peg-label", core.Go{
+		core.Sequence{
+			core.Root{"peg-atom", "Build"},
+			core.Root{"space", "string"},
+			core.Literal("^"),
+			core.Optional{core.Literal("^")},
+			core.Root{"space", "string"},
+			core.Root{"string-literal", "string"},
+		},
+		"Build",
+		"BuildLabel{arg.V0, arg.V5, arg.V3 != nil}",
+	})
+
 	state.DefineRoot(
 		"peg-sequence",
 		core.Go{
-			core.Plus{core.Root{"peg-unit", "Build"}},
+			core.Plus{core.Alternate{core.Root{"peg-cut", "Build"}, core.Root{"peg-label", "Build"}, core.Root{"peg-unit", "Build"}}},
 			"Build",
 			"BuildSequence(arg)",
 		},
@@ -127,7 +148,7 @@ func main() {
 					core.Go{
 						core.Sequence{
 							core.Root{"space", "string"},
-							core.Literal("go"),
+							core.Alternate{core.Literal("go"), core.Literal("try!"), core.Literal("try")},
 							core.Root{"keyword", "struct{}"},
 							core.Root{"type", "string"},
 							core.Root{"space", "string"},
@@ -136,7 +157,7 @@ func main() {
 							core.Literal("}"),
 						},
 						"BuildGo",
-						"BuildGo{nil, arg.V3, arg.V6}",
+						"BuildGo{nil, arg.V3, arg.V6, arg.V1}",
 					},
 				},
 			},
@@ -169,10 +190,27 @@ func main() {
 
 	state.DefineRoot("peg-expression", core.Root{"peg-alternate", "Build"})
 
+	state.DefineRoot(
+		"annotation",
+		core.Go{
+			core.Sequence{
+				core.Root{"space", "string"},
+				core.Alternate{
+					core.Literal("@memo"),
+					core.Literal("@nomemo"),
+				},
+				core.Root{"keyword", "struct{}"},
+			},
+			"string",
+			"arg.V1",
+		},
+	)
+
 	state.DefineRoot(
 		"rule",
 		core.Go{
 			core.Sequence{
+				core.Optional{core.Root{"annotation", "string"}},
 				core.Root{"identifier", "string"},
 				core.Root{"type", "string"},
 				core.Root{"space", "string"},
@@ -182,14 +220,39 @@ func main() {
 				core.Literal(";"),
 			},
 			"Rule",
-			"Rule{arg.V0, arg.V1, arg.V4}",
+			"Rule{arg.V1, arg.V2, arg.V5, arg.V0}",
 		},
 	)
 
 	state.DefineRoot(
 		"Rules",
-		core.Plus{core.Root{"rule", "Rule"}},
+		core.Go{
+			core.Sequence{core.Plus{core.Root{"rule", "Rule"}}, core.Root{"space", "string"}},
+			"[]Rule",
+			"arg.V0",
+		},
 	)
 
-	fmt.Print(state.Generate("main"))
+	grammar := flag.String("grammar", "", "generate the parser for the grammar in this file, rather than the parser of grammars")
+	packageName := flag.String("package", "main", "the package to generate the parser into")
+	options := core.Options{}
+	options.Flags(flag.CommandLine)
+	flag.Parse()
+	if *grammar != "" {
+		source, err := os.ReadFile(*grammar)
+		if err == nil {
+			state, err = ReadGrammar(source)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	source, pruned, err := state.GeneratePruned(*packageName, options)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Fprint(os.Stderr, pruned)
+	fmt.Print(source)
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nathan-fenner/go-peg-tree/core"
+)
+
+// An annotation overrides the policy for its rule: @nomemo keeps a root that
+// MemoShared would memoize from being memoized, and @memo memoizes a root that
+// MemoEvery wouldn't.
+func TestAnnotations(t *testing.T) {
+	state, err := ReadGrammar([]byte(`
+		@nomemo digits int <- regex "[0-9]"+ go int { len(arg.V0) };
+		@memo letters int <- regex "[a-z]"+ go int { len(arg.V0) };
+		plain int <- regex "[.]"+ go int { len(arg.V0) };
+		Text int <- digits? letters? plain? go int { 0 };
+	`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		policy core.MemoPolicy
+		memo   map[string]bool
+	}{
+		{core.MemoShared, map[string]bool{"digits": false, "letters": true, "plain": true}},
+		{core.MemoEvery, map[string]bool{"digits": false, "letters": true, "plain": false}},
+	} {
+		source, err := state.GenerateWith("main", core.Options{Policy: test.policy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for root, memo := range test.memo {
+			if got := strings.Contains(source, "\twhere"+state.Roots[root]+" "); got != memo {
+				t.Errorf("with policy %d, %s is memoized is %t, want %t", test.policy, root, got, memo)
+			}
+		}
+	}
+}